@@ -0,0 +1,59 @@
+package word2number
+
+import "regexp"
+
+// Operator tells how a qualifier bounds a number
+type Operator string
+
+// The operators of qualified numbers
+const (
+	Equal         Operator = "="
+	AtLeast       Operator = ">="
+	AtMost        Operator = "<="
+	MoreThan      Operator = ">"
+	LessThan      Operator = "<"
+	Approximately Operator = "~"
+)
+
+// Bound is a number together with the qualifier bounding it,
+// "not less than thirty days" is at least 30
+type Bound struct {
+	Operator Operator
+	Value    float64
+}
+
+type boundType struct {
+	pattern  *regexp.Regexp
+	operator Operator
+	after    bool
+}
+
+func newBoundType(m map[string]string) boundType {
+	return boundType{wordPattern(m["word"]), Operator(m["operator"]), m["after"] == "true"}
+}
+
+// Words2Bound finds the qualified number in words. A number without a qualifier is Equal.
+// Only the first number counts, five in "not less than five (6) days".
+// It returns false when there is no number.
+func (c *Converter) Words2Bound(words string) (Bound, bool) {
+	words = c.dropRestatements(words)
+	bound := Bound{Operator: Equal}
+	number := words
+	if i, m := first(words, len(c.bounds), func(i int) *regexp.Regexp { return c.bounds[i].pattern }); m != nil {
+		bound.Operator = c.bounds[i].operator
+		number = words[m[1]:]
+		if c.bounds[i].after {
+			number = words[:m[0]]
+		}
+	}
+	ms := c.matchWords(number)
+	if !ms.hasNumber() {
+		return Bound{}, false
+	}
+	run := 1
+	for run < len(ms) && adjacent(number, ms[run-1], ms[run]) {
+		run++
+	}
+	bound.Value = getNumber(ms[:run])
+	return bound, true
+}
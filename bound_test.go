@@ -0,0 +1,45 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConverter_Words2Bound(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c      *Converter
+		words  string
+		want   Bound
+		wantOk bool
+	}{
+		{en, "not less than thirty days", Bound{AtLeast, 30}, true},
+		{en, "not less than thirty (30) days", Bound{AtLeast, 30}, true},
+		{en, "at least thirty (30) days", Bound{AtLeast, 30}, true},
+		{en, "not less than five (6) days", Bound{AtLeast, 5}, true},
+		{en, "at least five business days", Bound{AtLeast, 5}, true},
+		{en, "up to five million dollars", Bound{AtMost, 5000000}, true},
+		{en, "no more than ten percent", Bound{AtMost, 0.1}, true},
+		{en, "not exceeding 500", Bound{AtMost, 500}, true},
+		{en, "in excess of two hundred thousand", Bound{MoreThan, 200000}, true},
+		{en, "more than three", Bound{MoreThan, 3}, true},
+		{en, "fewer than twelve", Bound{LessThan, 12}, true},
+		{en, "approximately forty", Bound{Approximately, 40}, true},
+		{en, "sixty days or more", Bound{AtLeast, 60}, true},
+		{en, "seven days", Bound{Equal, 7}, true},
+		{en, "at least", Bound{}, false},
+		{sv, "minst trettio dagar", Bound{AtLeast, 30}, true},
+		{sv, "högst fem procent", Bound{AtMost, 0.05}, true},
+		{sv, "inte mer än tio", Bound{AtMost, 10}, true},
+		{sv, "cirka 40", Bound{Approximately, 40}, true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			got, ok := tt.c.Words2Bound(tt.words)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Converter.Words2Bound(%s) = %v, %v, want %v, %v", tt.words, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
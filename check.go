@@ -0,0 +1,166 @@
+package word2number
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Mention is a number in a document, where it is and what it is worth. Unit is
+// the currency or the unit of measure of the number, USD for "$5" and days for "thirty days".
+type Mention struct {
+	Start int
+	End   int
+	Text  string
+	Value float64
+	Unit  string
+}
+
+// Mismatch is a number written in words followed by a numeral in parentheses
+// that says something else: "five (6) days"
+type Mismatch struct {
+	Words   Mention
+	Numeral Mention
+}
+
+// Mentions finds the numbers in a document. A number in words followed by the same
+// number in parentheses, "thirty (30) days", is one mention and not two.
+func (c *Converter) Mentions(document string) []Mention {
+	mentions, _ := c.pairMentions(document)
+	return mentions
+}
+
+// Check finds the numbers in words followed by a numeral in parentheses
+// that don't agree, "Ten Thousand Dollars ($1,000)"
+func (c *Converter) Check(document string) []Mismatch {
+	_, mismatches := c.pairMentions(document)
+	return mismatches
+}
+
+// pairMentions finds the mentions in a document, merging the pairs that agree
+// and returning the ones that don't as mismatches
+func (c *Converter) pairMentions(document string) (mentions []Mention, mismatches []Mismatch) {
+	found := c.findMentions(document)
+	for i := 0; i < len(found); i++ {
+		m := found[i]
+		m.Unit = c.unitOf(document, m.Start, m.End)
+		if i+1 < len(found) {
+			if end, ok := restated(document, m, found[i+1]); ok {
+				next := found[i+1]
+				i++
+				// The unit is around the pair, "five (6) days" and "Ten Dollars ($10)"
+				m.Unit = c.unitOf(document, m.Start, end)
+				next.Unit = m.Unit
+				if !sameNumber(m.Value, next.Value) {
+					mismatches = append(mismatches, Mismatch{m, next})
+					mentions = append(mentions, m, next)
+					continue
+				}
+				m.End = end
+				m.Text = document[m.Start:m.End]
+			}
+		}
+		mentions = append(mentions, m)
+	}
+	return
+}
+
+// findMentions groups the matches next to each other into numbers
+func (c *Converter) findMentions(document string) (mentions []Mention) {
+	ms := c.matchWords(document)
+	for i := 0; i < len(ms); {
+		j := i + 1
+		for j < len(ms) && (adjacent(document, ms[j-1], ms[j]) || continues(document, ms[i:j], ms[j:])) {
+			j++
+		}
+		group := ms[i:j]
+		// Not the and in "thirty days and 45 days"
+		for len(group) > 0 && group[0].tyype == weakDecimalKey {
+			group = group[1:]
+		}
+		for len(group) > 0 && group[len(group)-1].tyype == weakDecimalKey {
+			group = group[:len(group)-1]
+		}
+		if group.hasNumber() {
+			start, end := group[0].start, group[len(group)-1].end
+			mentions = append(mentions, Mention{start, end, document[start:end], c.Words2Number(document[start:end]), ""})
+		}
+		i = j
+	}
+	return
+}
+
+// continues tells if the matches after a comma go on with the number before it, a smaller
+// magnitude following a larger one: "Forty-Eight Million, Four Hundred Thousand"
+func continues(document string, before, after matches) bool {
+	if strings.Trim(document[before[len(before)-1].end:after[0].start], " \t\n") != "," || !isCount(after[0]) {
+		return false
+	}
+	var magnitude float64
+	for _, m := range before {
+		if m.tyype == multiKey {
+			magnitude = m.numeric
+		}
+	}
+	for i, m := range after {
+		if i > 0 && !adjacent(document, after[i-1], m) {
+			break
+		}
+		if m.tyype == multiKey && m.numeric >= magnitude {
+			return false
+		}
+	}
+	return magnitude > 0
+}
+
+// unitOf finds the currency or the unit of measure of the number between start and end,
+// looking at the word on each side of it
+func (c *Converter) unitOf(document string, start, end int) string {
+	before := strings.Fields(document[:start])
+	after := strings.Fields(document[end:])
+	span := document[start:end]
+	if len(before) > 0 && !strings.HasSuffix(document[:start], " ") {
+		// The $ in "$5"
+		span = before[len(before)-1] + span
+	}
+	next := ""
+	if len(after) > 0 {
+		next = strings.Trim(after[0], ".,;:()")
+		span += " " + next
+	}
+	if money, ok := c.Words2Money(span); ok {
+		return money.Currency
+	}
+	if q, ok := c.Words2Quantity(span); ok {
+		return q.Unit
+	}
+	for _, d := range c.durations {
+		if m := d.pattern.FindStringIndex(next); m != nil && m[0] == 0 && m[1] == len(next) {
+			return d.unit
+		}
+	}
+	return ""
+}
+
+// restated tells if the second mention is in parentheses right after the first one,
+// and where the parentheses end: "five (6)", "Ten Thousand Dollars ($1,000)"
+func restated(document string, first, second Mention) (int, bool) {
+	open := document[first.End:second.Start]
+	if !strings.HasPrefix(strings.TrimSpace(open), "(") || !onlyPunct(open) {
+		return 0, false
+	}
+	closing := strings.Index(document[second.End:], ")")
+	if closing < 0 || !onlyPunct(document[second.End:second.End+closing]) {
+		return 0, false
+	}
+	return second.End + closing + 1, true
+}
+
+// onlyPunct tells if there are no letters or digits in s
+func onlyPunct(s string) bool {
+	return strings.IndexFunc(s, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) < 0
+}
+
+func sameNumber(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(math.Abs(a), math.Abs(b))
+}
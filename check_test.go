@@ -0,0 +1,56 @@
+package word2number
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestConverter_Check(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c        *Converter
+		document string
+		want     []Mismatch
+	}{
+		{en, "within five (6) days", []Mismatch{{Mention{7, 11, "five", 5, "days"}, Mention{13, 14, "6", 6, "days"}}}},
+		{en, "Ten Thousand Dollars ($1,000)", []Mismatch{{Mention{0, 20, "Ten Thousand Dollars", 10000, "USD"}, Mention{23, 28, "1,000", 1000, "USD"}}}},
+		{en, "within thirty (30) days", nil},
+		{en, "five percent (5%) of the price", nil},
+		{en, "thirty days and 45 days", nil},
+		{en, "Forty-Eight Million, Four Hundred Thousand Dollars ($48,400,000)", nil},
+		{en, "Two Million, Five Hundred Thousand Dollars ($2,000,000)", []Mismatch{{Mention{0, 42, "Two Million, Five Hundred Thousand Dollars", 2500000, "USD"}, Mention{45, 54, "2,000,000", 2000000, "USD"}}}},
+		{sv, "inom fem (6) dagar", []Mismatch{{Mention{5, 8, "fem", 5, "days"}, Mention{10, 11, "6", 6, "days"}}}},
+		{sv, "inom trettio (30) dagar", nil},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := tt.c.Check(tt.document); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Converter.Check(%s) = %v, want %v", tt.document, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConverter_Mentions(t *testing.T) {
+	en, _ := NewConverter("en")
+	tests := []struct {
+		c        *Converter
+		document string
+		want     []Mention
+	}{
+		{en, "within thirty (30) days", []Mention{{7, 18, "thirty (30)", 30, "days"}}},
+		{en, "within five (6) days", []Mention{{7, 11, "five", 5, "days"}, {13, 14, "6", 6, "days"}}},
+		{en, "thirty days and 45 days", []Mention{{0, 6, "thirty", 30, "days"}, {16, 18, "45", 45, "days"}}},
+		{en, "(i) five (5) days", []Mention{{4, 12, "five (5)", 5, "days"}}},
+		{en, "no numbers here", nil},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := tt.c.Mentions(tt.document); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Converter.Mentions(%s) = %v, want %v", tt.document, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package word2number
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TimeOfDay is a time on the 24 hour clock, "half past three p.m." is 15:30
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+}
+
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)
+}
+
+// The ways clock words relate to the hour
+const (
+	pastClock   = "past"   // minutes past the hour after it: ten past three
+	toClock     = "to"     // minutes to the hour after it: quarter to four, halv tre
+	oclockClock = "oclock" // after the hour: five o'clock
+	prefixClock = "prefix" // in front of the hour: klockan fem
+	namedClock  = "named"  // an hour of its own: noon
+	amClock     = "am"
+	pmClock     = "pm"
+)
+
+type clockType struct {
+	word     string
+	pattern  *regexp.Regexp
+	relation string
+	minutes  int
+	hour     int
+}
+
+func newClockType(m map[string]string) (c clockType) {
+	c.word = m["word"]
+	c.pattern = wordPattern(m["word"])
+	c.relation = m["relation"]
+	c.minutes, _ = strconv.Atoi(m["minutes"])
+	c.hour, _ = strconv.Atoi(m["hour"])
+	return
+}
+
+// clockPattern matches 17:30 and 17.30, the separator is captured
+var clockPattern = regexp.MustCompile(`\b([01]?\d|2[0-3])([:.])([0-5]\d)\b`)
+
+// Words2Time finds a time of day in words: "by five o'clock p.m.", "quarter to noon",
+// "fifteen hundred hours", 17:30, "by 5 p.m." or "kl. halv tre". A time with a point,
+// 5.30, needs a clock word next to it. It returns false when there is none.
+func (c *Converter) Words2Time(words string) (TimeOfDay, bool) {
+	t, ok := c.clockTime(words)
+	if !ok {
+		return TimeOfDay{}, false
+	}
+	for _, ct := range c.clock {
+		if ct.relation != amClock && ct.relation != pmClock || !ct.pattern.MatchString(words) || t.Hour > 12 {
+			continue
+		}
+		if ct.relation == pmClock && t.Hour < 12 {
+			t.Hour += 12
+		} else if ct.relation == amClock && t.Hour == 12 {
+			t.Hour = 0
+		}
+		break
+	}
+	return t, true
+}
+
+// clockTime reads the time in words without the half of the day
+func (c *Converter) clockTime(words string) (TimeOfDay, bool) {
+	for _, m := range clockPattern.FindAllStringSubmatchIndex(words, -1) {
+		if words[m[4]:m[5]] == "." && !c.nextToClock(words, m[0], m[1]) {
+			// Not a time: $12.50, version 1.10
+			continue
+		}
+		h, _ := strconv.Atoi(words[m[2]:m[3]])
+		min, _ := strconv.Atoi(words[m[6]:m[7]])
+		return TimeOfDay{h, min}, true
+	}
+	if ct, m := c.firstClock(words, pastClock, toClock); m != nil {
+		before, after := words[:m[0]], words[m[1]:]
+		minutes := ct.minutes
+		if minutes == 0 {
+			run := c.matchWords(before).tail(before)
+			if len(run) == 0 {
+				return TimeOfDay{}, false
+			}
+			minutes = int(getNumber(run))
+		}
+		hour, end, ok := c.hourAt(after)
+		if !ok || minutes <= 0 || minutes >= 60 || c.measuredAhead(after[end:]) {
+			// Not a time: "five to ten days", "from 3 to 5 percent"
+			return TimeOfDay{}, false
+		}
+		if ct.relation == toClock {
+			return TimeOfDay{(hour + 23) % 24, 60 - minutes}, true
+		}
+		return TimeOfDay{hour, minutes}, true
+	}
+	if _, m := c.firstClock(words, oclockClock); m != nil {
+		before := words[:m[0]]
+		if run := c.matchWords(before).tail(before); len(run) > 0 {
+			return clockNumber(run)
+		}
+	}
+	if _, m := c.firstClock(words, prefixClock); m != nil {
+		after := words[m[1]:]
+		if run := c.matchWords(after).head(after); len(run) > 0 {
+			return clockNumber(run)
+		}
+	}
+	if _, m := c.firstClock(words, amClock, pmClock); m != nil {
+		before := words[:m[0]]
+		if run := c.matchWords(before).tail(before); len(run) > 0 {
+			return clockNumber(run)
+		}
+	}
+	if ct, m := c.firstClock(words, namedClock); m != nil {
+		return TimeOfDay{ct.hour, 0}, true
+	}
+	return TimeOfDay{}, false
+}
+
+// firstClock finds the first of the clock words with one of the relations,
+// the longest if they start at the same place
+func (c *Converter) firstClock(words string, relations ...string) (clockType, []int) {
+	i, at := first(words, len(c.clock), func(i int) *regexp.Regexp {
+		if !hasRelation(relations, c.clock[i].relation) {
+			return nil
+		}
+		return c.clock[i].pattern
+	})
+	if at == nil {
+		return clockType{}, nil
+	}
+	return c.clock[i], at
+}
+
+func hasRelation(relations []string, relation string) bool {
+	for _, r := range relations {
+		if r == relation {
+			return true
+		}
+	}
+	return false
+}
+
+// hourAt reads the hour at the start of words, "three" or "noon", and where it ends
+func (c *Converter) hourAt(words string) (int, int, bool) {
+	if run := c.matchWords(words).head(words); len(run) > 0 {
+		t, ok := clockNumber(run)
+		return t.Hour, run[len(run)-1].end, ok && t.Minute == 0
+	}
+	for _, ct := range c.clock {
+		if ct.relation != namedClock {
+			continue
+		}
+		if end, ok := atStart(words, 0, []counterType{{pattern: ct.pattern}}); ok {
+			return ct.hour, end, true
+		}
+	}
+	return 0, 0, false
+}
+
+// nextToClock tells if a clock word other than past or to comes right before start
+// or right after end, the p.m. in "5.30 p.m." and the kl. in "kl. 14.30"
+func (c *Converter) nextToClock(words string, start, end int) bool {
+	before := strings.TrimRight(words[:start], " \t\n")
+	for _, ct := range c.clock {
+		if ct.relation == pastClock || ct.relation == toClock {
+			continue
+		}
+		if _, ok := atStart(words, end, []counterType{{pattern: ct.pattern}}); ok {
+			return true
+		}
+		if ms := ct.pattern.FindAllStringIndex(before, -1); len(ms) > 0 && ms[len(ms)-1][1] == len(before) {
+			return true
+		}
+	}
+	return false
+}
+
+// measuredAhead tells if words start with a unit of time or measure, a currency or
+// a percent, the days in "five to ten days"
+func (c *Converter) measuredAhead(words string) bool {
+	var ts []counterType
+	for _, d := range c.durations {
+		ts = append(ts, counterType{pattern: d.pattern})
+	}
+	for _, u := range c.units {
+		ts = append(ts, counterType{pattern: u.pattern})
+	}
+	for _, cur := range c.currencies {
+		ts = append(ts, counterType{pattern: cur.pattern})
+	}
+	if _, ok := atStart(words, 0, ts); ok {
+		return true
+	}
+	ms := c.matchWords(words)
+	return len(ms) > 0 && (ms[0].tyype == percentKey || ms[0].tyype == pointsKey) &&
+		strings.TrimSpace(words[:ms[0].start]) == ""
+}
+
+// clockNumber reads the numbers of a time: "five" is 5:00, "five thirty" is 5:30
+// and "fifteen hundred" is 15:00
+func clockNumber(run matches) (TimeOfDay, bool) {
+	n := int(getValues(run))
+	t := TimeOfDay{n, 0}
+	if n >= 100 {
+		t = TimeOfDay{n / 100, n % 100}
+	} else if len(run) > 1 && run[0].tyype == countKey && run[0].numeric <= 24 && !run[1:].has(multiKey) {
+		t = TimeOfDay{int(run[0].numeric), int(getValues(run[1:]))}
+	}
+	return t, t.Hour < 24 && t.Minute < 60
+}
+
+// Time2Words says a time of day on the twelve hour clock, "half past three p.m."
+func (c *Converter) Time2Words(t TimeOfDay) string {
+	hour, to := t.Hour, false
+	var words []string
+	switch {
+	case t.Minute == 0:
+	case c.hasClock(toClock, 60-t.Minute) || !c.hasClock(pastClock, t.Minute) && t.Minute > 30:
+		// Quarter to four, and halv tre for 2:30 in Swedish
+		hour, to = (hour+1)%24, true
+		words = c.relationWords(toClock, 60-t.Minute)
+	default:
+		words = c.relationWords(pastClock, t.Minute)
+	}
+	if ct, ok := c.namedHour(hour); ok && (t.Minute == 0 || to) {
+		return strings.Join(append(words, ct.word), " ")
+	}
+	h := strings.Join(c.intWords((hour+11)%12+1), " ")
+	if t.Minute > 0 {
+		words = append(words, h)
+	} else if ct, ok := c.clockWord(prefixClock, 0); ok {
+		words = append(words, ct.word, h)
+	} else if ct, ok := c.clockWord(oclockClock, 0); ok {
+		words = append(words, h, ct.word)
+	}
+	half := amClock
+	if t.Hour >= 12 {
+		half = pmClock
+	}
+	if ct, ok := c.clockWord(half, 0); ok {
+		words = append(words, ct.word)
+	}
+	return strings.Join(words, " ")
+}
+
+// relationWords are the words for minutes past or to the hour: "quarter past", "ten to"
+func (c *Converter) relationWords(relation string, minutes int) []string {
+	if ct, ok := c.clockWord(relation, minutes); ok {
+		return []string{ct.word}
+	}
+	ct, _ := c.clockWord(relation, 0)
+	return append(c.intWords(minutes), ct.word)
+}
+
+func (c *Converter) hasClock(relation string, minutes int) bool {
+	_, ok := c.clockWord(relation, minutes)
+	return ok
+}
+
+// clockWord returns the first clock word with the relation and minutes
+func (c *Converter) clockWord(relation string, minutes int) (clockType, bool) {
+	for _, ct := range c.clock {
+		if ct.relation == relation && ct.minutes == minutes {
+			return ct, true
+		}
+	}
+	return clockType{}, false
+}
+
+func (c *Converter) namedHour(hour int) (clockType, bool) {
+	for _, ct := range c.clock {
+		if ct.relation == namedClock && ct.hour == hour {
+			return ct, true
+		}
+	}
+	return clockType{}, false
+}
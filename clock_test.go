@@ -0,0 +1,80 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConverter_Words2Time(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c      *Converter
+		words  string
+		want   TimeOfDay
+		wantOk bool
+	}{
+		{en, "by five o'clock p.m.", TimeOfDay{17, 0}, true},
+		{en, "half past three", TimeOfDay{3, 30}, true},
+		{en, "quarter to noon", TimeOfDay{11, 45}, true},
+		{en, "fifteen hundred hours", TimeOfDay{15, 0}, true},
+		{en, "ten past nine in the morning", TimeOfDay{9, 10}, true},
+		{en, "twenty to six pm", TimeOfDay{17, 40}, true},
+		{en, "at 17:30", TimeOfDay{17, 30}, true},
+		{en, "5:30 p.m.", TimeOfDay{17, 30}, true},
+		{en, "twelve o'clock a.m.", TimeOfDay{0, 0}, true},
+		{en, "midnight", TimeOfDay{0, 0}, true},
+		{en, "agreed to pay five dollars", TimeOfDay{}, false},
+		{en, "forty-eight hours", TimeOfDay{}, false},
+		{en, "by 5 p.m.", TimeOfDay{17, 0}, true},
+		{en, "five pm", TimeOfDay{17, 0}, true},
+		{en, "5.30 p.m.", TimeOfDay{17, 30}, true},
+		{en, "$12.50", TimeOfDay{}, false},
+		{en, "interest of 3.45%", TimeOfDay{}, false},
+		{en, "version 1.10", TimeOfDay{}, false},
+		{en, "five to ten days", TimeOfDay{}, false},
+		{en, "from 3 to 5 percent", TimeOfDay{}, false},
+		{en, "ten to five", TimeOfDay{4, 50}, true},
+		{sv, "kl. halv tre", TimeOfDay{2, 30}, true},
+		{sv, "klockan fem på eftermiddagen", TimeOfDay{17, 0}, true},
+		{sv, "kvart i fyra", TimeOfDay{3, 45}, true},
+		{sv, "tio över två", TimeOfDay{2, 10}, true},
+		{sv, "kl. 14.30", TimeOfDay{14, 30}, true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			got, ok := tt.c.Words2Time(tt.words)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Converter.Words2Time(%s) = %v, %v, want %v, %v", tt.words, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestConverter_Time2Words(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c    *Converter
+		time TimeOfDay
+		want string
+	}{
+		{en, TimeOfDay{17, 0}, "five o'clock p.m."},
+		{en, TimeOfDay{15, 30}, "half past three p.m."},
+		{en, TimeOfDay{11, 45}, "quarter to noon"},
+		{en, TimeOfDay{9, 10}, "ten past nine a.m."},
+		{en, TimeOfDay{17, 40}, "twenty to six p.m."},
+		{en, TimeOfDay{0, 0}, "midnight"},
+		{sv, TimeOfDay{14, 30}, "halv tre på eftermiddagen"},
+		{sv, TimeOfDay{8, 0}, "klockan åtta på förmiddagen"},
+		{sv, TimeOfDay{3, 45}, "kvart i fyra på förmiddagen"},
+		{sv, TimeOfDay{2, 10}, "tio över två på förmiddagen"},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := tt.c.Time2Words(tt.time); got != tt.want {
+				t.Errorf("Converter.Time2Words(%v) = %s, want %s", tt.time, got, tt.want)
+			}
+		})
+	}
+}
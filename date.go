@@ -0,0 +1,149 @@
+package word2number
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateFormat is how a locale writes a date in full, with {day}, {month} and {year}
+// in it, and the separator between the tens and the ones of a day: twenty-first
+type dateFormat struct {
+	format    string
+	separator string
+}
+
+// Words2Date finds a date in words, like "this fifteenth day of March, two thousand twenty",
+// "the 1st day of January 2021" or "March 15, 2021". It returns false when there is
+// no whole date. A month without a day and a year around it, the "may" in
+// "the Buyer may pay on 15 March 2021", is passed over.
+func (c *Converter) Words2Date(words string) (time.Time, bool) {
+	var months [][]int
+	for i, m := range c.months {
+		for _, found := range m.pattern.FindAllStringIndex(words, -1) {
+			months = append(months, []int{found[0], found[1], i})
+		}
+	}
+	sort.Slice(months, func(i, j int) bool { return months[i][0] < months[j][0] })
+	for _, m := range months {
+		if t, ok := c.dateAt(words, m[:2], c.months[m[2]].value); ok {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// dateAt reads the date around the month at the given position in words
+func (c *Converter) dateAt(words string, month []int, number float64) (time.Time, bool) {
+	rest := words[month[1]:]
+	day, ok := c.dayBefore(words[:month[0]])
+	if !ok {
+		var end int
+		if day, end, ok = c.dayAfter(rest); !ok {
+			return time.Time{}, false
+		}
+		rest = rest[end:]
+	}
+	rest = strings.TrimLeft(rest, ", \t\n")
+	year := c.matchWords(rest).head(rest)
+	if len(year) == 0 {
+		return time.Time{}, false
+	}
+	t := time.Date(c.Words2Year(rest[:year[len(year)-1].end]), time.Month(number), day, 0, 0, 0, 0, time.UTC)
+	if t.Day() != day {
+		// The 31st of April
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// dayBefore reads the day in front of the month, "fifteenth day of" or "15"
+func (c *Converter) dayBefore(words string) (int, bool) {
+	// The words between the day and the month, all of them or the last ones: "day of", "of"
+	var between []string
+	if i, j := strings.Index(c.dateFormat.format, "{day}"), strings.Index(c.dateFormat.format, "{month}"); i >= 0 && j > i {
+		between = strings.Fields(c.dateFormat.format[i+len("{day}") : j])
+	}
+	words = strings.TrimRight(words, " ,\t\n")
+	for k := range between {
+		suffix := strings.Join(between[k:], " ")
+		if strings.HasSuffix(strings.ToLower(words), strings.ToLower(suffix)) && !inWord(words, len(words)-len(suffix)) {
+			words = strings.TrimRight(words[:len(words)-len(suffix)], " \t\n")
+			break
+		}
+	}
+	if c.ordinalPattern != nil {
+		if m := c.ordinalPattern.FindAllStringSubmatchIndex(words, -1); len(m) > 0 && m[len(m)-1][1] == len(words) {
+			last := m[len(m)-1]
+			n, _ := strconv.Atoi(words[last[2]:last[3]])
+			return validDay(n)
+		}
+	}
+	for _, o := range c.ordinals {
+		m := o.pattern.FindAllStringIndex(words, -1)
+		if len(m) == 0 || m[len(m)-1][1] != len(words) {
+			continue
+		}
+		before := words[:m[len(m)-1][0]]
+		n := o.value
+		if run := c.matchWords(before).tail(before); len(run) > 0 {
+			n += getValues(run)
+		}
+		return validDay(int(n))
+	}
+	if run := c.matchWords(words).tail(words); len(run) > 0 {
+		return validDay(int(getNumber(run)))
+	}
+	return 0, false
+}
+
+// dayAfter reads the day after the month, "15" in "March 15, 2021", and where it ends
+func (c *Converter) dayAfter(words string) (int, int, bool) {
+	if c.ordinalPattern != nil {
+		if m := c.ordinalPattern.FindStringSubmatchIndex(words); m != nil && strings.TrimSpace(words[:m[0]]) == "" {
+			n, _ := strconv.Atoi(words[m[2]:m[3]])
+			day, ok := validDay(n)
+			return day, m[1], ok
+		}
+	}
+	run := c.matchWords(words).head(words)
+	if len(run) == 0 {
+		return 0, 0, false
+	}
+	day, ok := validDay(int(getNumber(run)))
+	return day, run[len(run)-1].end, ok
+}
+
+func validDay(n int) (int, bool) {
+	return n, 1 <= n && n <= 31
+}
+
+// Date2Words writes a date in full the way contracts do,
+// "the fifteenth day of March, two thousand twenty"
+func (c *Converter) Date2Words(t time.Time) string {
+	var month string
+	for _, m := range c.months {
+		if int(m.value) == int(t.Month()) {
+			month = m.word
+			break
+		}
+	}
+	// The year is said as a cardinal in legal text, two thousand twenty
+	year := strings.Join(c.yearWords(t.Year(), yearRule{style: cardinalStyle}), c.yearRule(t.Year()).separator)
+	return strings.NewReplacer("{day}", c.ordinalWords(t.Day()), "{month}", month, "{year}", year).
+		Replace(c.dateFormat.format)
+}
+
+// ordinalWords spells an ordinal below a hundred, twenty-first
+func (c *Converter) ordinalWords(n int) string {
+	for _, o := range c.ordinals {
+		if int(o.value) == n {
+			return o.word
+		}
+	}
+	if n > 20 && n < 100 {
+		return c.words[n/10*10] + c.dateFormat.separator + c.ordinalWords(n%10)
+	}
+	return strconv.Itoa(n)
+}
@@ -0,0 +1,68 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestConverter_Words2Date(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c      *Converter
+		words  string
+		want   time.Time
+		wantOk bool
+	}{
+		{en, "this fifteenth day of March, two thousand twenty", date(2020, 3, 15), true},
+		{en, "the 1st day of January 2021", date(2021, 1, 1), true},
+		{en, "the twenty-first day of June, 2019", date(2019, 6, 21), true},
+		{en, "March 15, 2021", date(2021, 3, 15), true},
+		{en, "15 March 2021", date(2021, 3, 15), true},
+		{en, "on the 4th of July, nineteen eighty-four", date(1984, 7, 4), true},
+		{en, "the Buyer may pay on 15 March 2021", date(2021, 3, 15), true},
+		{en, "15 May 2021", date(2021, 5, 15), true},
+		{en, "the thirty-first day of April, 2021", time.Time{}, false},
+		{en, "in March 2021", time.Time{}, false},
+		{en, "fifteen days", time.Time{}, false},
+		{sv, "den femtonde mars tjugohundratjugo", date(2020, 3, 15), true},
+		{sv, "den 1 januari 2021", date(2021, 1, 1), true},
+		{sv, "den 21:a juni 2019", date(2019, 6, 21), true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			got, ok := tt.c.Words2Date(tt.words)
+			if !got.Equal(tt.want) || ok != tt.wantOk {
+				t.Errorf("Converter.Words2Date(%s) = %v, %v, want %v, %v", tt.words, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestConverter_Date2Words(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c    *Converter
+		date time.Time
+		want string
+	}{
+		{en, date(2020, 3, 15), "the fifteenth day of March, two thousand twenty"},
+		{en, date(2021, 1, 1), "the first day of January, two thousand twenty one"},
+		{en, date(1999, 12, 31), "the thirty-first day of December, one thousand nine hundred ninety nine"},
+		{sv, date(2020, 3, 15), "den femtonde mars tvåtusentjugo"},
+		{sv, date(2021, 6, 21), "den tjugoförsta juni tvåtusentjugoen"},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := tt.c.Date2Words(tt.date); got != tt.want {
+				t.Errorf("Converter.Date2Words(%v) = %s, want %s", tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func date(year, month, day int) time.Time {
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
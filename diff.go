@@ -0,0 +1,135 @@
+package word2number
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ChangeKind tells how a number changed between two versions of a document
+type ChangeKind string
+
+// The kinds of changes
+const (
+	Added   ChangeKind = "added"
+	Removed ChangeKind = "removed"
+	Changed ChangeKind = "changed"
+)
+
+// Change is a number that was added, removed or changed between two versions of a document
+type Change struct {
+	Kind ChangeKind
+	Old  Mention
+	New  Mention
+}
+
+// contextWords is how many words on each side of a number tell where it is
+const contextWords = 3
+
+// Diff finds the numbers that differ between two versions of a document. The numbers
+// are paired up by the words around them, the words on the side that agrees best and
+// the nearest words weighing the most, so "thirty days" becoming "45 days" is a
+// change, and "thirty days" becoming "30 days" is none. A new currency or unit of
+// measure, "thirty days" becoming "thirty months", is a change too.
+func (c *Converter) Diff(before, after string) (changes []Change) {
+	olds, news := c.Mentions(before), c.Mentions(after)
+	type pair struct {
+		old, new    int
+		best, other float64
+	}
+	var pairs []pair
+	for i, o := range olds {
+		oldBefore, oldAfter := context(before, o)
+		for j, n := range news {
+			newBefore, newAfter := context(after, n)
+			best, other := similarity(oldBefore, newBefore), similarity(oldAfter, newAfter)
+			if other > best {
+				best, other = other, best
+			}
+			if best > 0 {
+				pairs = append(pairs, pair{i, j, best, other})
+			}
+		}
+	}
+	// The most similar first, and the closest in place of those
+	sort.SliceStable(pairs, func(a, b int) bool {
+		if pairs[a].best != pairs[b].best {
+			return pairs[a].best > pairs[b].best
+		}
+		if pairs[a].other != pairs[b].other {
+			return pairs[a].other > pairs[b].other
+		}
+		return abs(pairs[a].old-pairs[a].new) < abs(pairs[b].old-pairs[b].new)
+	})
+	oldTo, newTo := make(map[int]int), make(map[int]bool)
+	for _, p := range pairs {
+		if _, ok := oldTo[p.old]; ok || newTo[p.new] {
+			continue
+		}
+		oldTo[p.old], newTo[p.new] = p.new, true
+	}
+	for i, o := range olds {
+		j, ok := oldTo[i]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Kind: Removed, Old: o})
+		case !sameNumber(o.Value, news[j].Value) || o.Unit != news[j].Unit:
+			changes = append(changes, Change{Kind: Changed, Old: o, New: news[j]})
+		}
+	}
+	for j, n := range news {
+		if !newTo[j] {
+			changes = append(changes, Change{Kind: Added, New: n})
+		}
+	}
+	return
+}
+
+// context returns the words on each side of a mention without the numbers,
+// the nearest first
+func context(document string, m Mention) (before, after []string) {
+	isWord := func(r rune) bool { return !unicode.IsLetter(r) }
+	words := strings.FieldsFunc(strings.ToLower(document[:m.Start]), isWord)
+	for i := len(words) - 1; i >= 0 && len(before) < contextWords; i-- {
+		before = append(before, words[i])
+	}
+	after = strings.FieldsFunc(strings.ToLower(document[m.End:]), isWord)
+	if len(after) > contextWords {
+		after = after[:contextWords]
+	}
+	return
+}
+
+// similarity is how much of the words are in both, the nearest first. A word weighs
+// one over how far it is from the number, at the farther of its places in the two.
+func similarity(a, b []string) float64 {
+	at := make(map[string]int)
+	for k := len(b) - 1; k >= 0; k-- {
+		at[b[k]] = k
+	}
+	var both, all float64
+	for k := 0; k < len(a) || k < len(b); k++ {
+		all += 1 / float64(k+1)
+		if k >= len(a) {
+			continue
+		}
+		if j, ok := at[a[k]]; ok {
+			if j < k {
+				j = k
+			}
+			both += 1 / float64(j+1)
+			delete(at, a[k])
+		}
+	}
+	if all == 0 {
+		return 0
+	}
+	return both / all
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,40 @@
+package word2number
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestConverter_Diff(t *testing.T) {
+	en, _ := NewConverter("en")
+	tests := []struct {
+		c      *Converter
+		before string
+		after  string
+		want   []Change
+	}{
+		{en, "payment within thirty days", "payment within 45 days",
+			[]Change{{Changed, Mention{15, 21, "thirty", 30, "days"}, Mention{15, 17, "45", 45, "days"}}}},
+		{en, "payment within thirty days", "payment within 30 days", nil},
+		{en, "a fee of five percent and a term of two years", "a fee of 5% and a term of three years",
+			[]Change{{Changed, Mention{36, 39, "two", 2, "years"}, Mention{26, 31, "three", 3, "years"}}}},
+		{en, "a deposit of ten dollars", "a deposit of ten dollars and a fee of 2 dollars",
+			[]Change{{Kind: Added, New: Mention{38, 47, "2 dollars", 2, "USD"}}}},
+		{en, "a term of two years and a cap of 5 million", "a term of two years",
+			[]Change{{Kind: Removed, Old: Mention{33, 42, "5 million", 5000000, ""}}}},
+		{en, "a fee of five dollars and a deposit of ten dollars", "a deposit of ten dollars and a fee of six dollars",
+			[]Change{{Changed, Mention{9, 21, "five dollars", 5, "USD"}, Mention{38, 49, "six dollars", 6, "USD"}}}},
+		{en, "payment within thirty days", "payment within thirty months",
+			[]Change{{Changed, Mention{15, 21, "thirty", 30, "days"}, Mention{15, 21, "thirty", 30, "months"}}}},
+		{en, "a price of $5,000", "a price of €5,000",
+			[]Change{{Changed, Mention{12, 17, "5,000", 5000, "USD"}, Mention{14, 19, "5,000", 5000, "EUR"}}}},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := tt.c.Diff(tt.before, tt.after); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Converter.Diff(%s, %s) = %v, want %v", tt.before, tt.after, got, tt.want)
+			}
+		})
+	}
+}
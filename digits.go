@@ -0,0 +1,135 @@
+package word2number
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Words2Digits takes a spelled sequence of digits, like a phone or account number,
+// and returns the digits. "oh seven oh" is "070" and "four one five double five" is "41555".
+func (c *Converter) Words2Digits(words string) string {
+	ms := c.matchWords(words)
+	for _, r := range c.repeaters {
+		for _, m := range r.pattern.FindAllStringIndex(words, -1) {
+			ms = append(ms, newMatch(repeatKey, m, words, r.value, true))
+		}
+	}
+	sort.Sort(ms)
+
+	var digits []string
+	repeat := 1
+	tens := false
+	for _, m := range ms {
+		switch m.tyype {
+		case repeatKey:
+			repeat = int(m.numeric)
+		case countKey:
+			if isNumeral(m) {
+				digits = append(digits, strings.Repeat(onlyDigits(m.value), repeat))
+				repeat, tens = 1, false
+				continue
+			}
+			if !isWhole(words, m) {
+				// "one" in "phone"
+				continue
+			}
+			n := int(m.numeric)
+			if tens && n > 0 && n < 10 {
+				// forty-two
+				last := digits[len(digits)-1]
+				digits[len(digits)-1] = last[:len(last)-1] + strconv.Itoa(n)
+				tens = false
+				continue
+			}
+			digits = append(digits, strings.Repeat(strconv.Itoa(n), repeat))
+			tens = repeat == 1 && n >= 20 && n < 100 && n%10 == 0
+			repeat = 1
+		case multiKey:
+			// eight hundred
+			if len(digits) > 0 {
+				digits[len(digits)-1] += strings.TrimPrefix(strconv.Itoa(int(m.numeric)), "1")
+			}
+			tens = false
+		}
+	}
+	return strings.Join(digits, "")
+}
+
+// repeatsDigit tells if the words at m are a repeater followed by a single digit,
+// the "double" in "four one five double five"
+func (c *Converter) repeatsDigit(words string, m []int) bool {
+	repeater := false
+	for _, r := range c.repeaters {
+		if r.pattern.FindString(words[m[0]:m[1]]) == words[m[0]:m[1]] {
+			repeater = true
+		}
+	}
+	if !repeater {
+		return false
+	}
+	rest := strings.TrimLeft(words[m[1]:], " \t\n")
+	if rest != "" && '0' <= rest[0] && rest[0] <= '9' {
+		return len(rest) == 1 || rest[1] < '0' || rest[1] > '9'
+	}
+	for _, ct := range c.counters {
+		if ct.value >= 10 || ct.value != float64(int(ct.value)) {
+			continue
+		}
+		if d := ct.pattern.FindStringIndex(rest); d != nil && d[0] == 0 && isWhole(rest, newMatch(countKey, d, rest, 0, true)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Digits2Words spells a sequence of digits one by one, with repeated digits
+// said as "double five". Anything that isn't a digit is left out.
+func (c *Converter) Digits2Words(digits string) string {
+	digits = onlyDigits(digits)
+	var words []string
+	for i := 0; i < len(digits); {
+		run := 1
+		for i+run < len(digits) && digits[i+run] == digits[i] {
+			run++
+		}
+		word := c.words[int(digits[i]-'0')]
+		for run > 0 {
+			n, repeater := 1, ""
+			for _, r := range c.repeaters {
+				if k := int(r.value); k <= run && k > n {
+					n, repeater = k, r.word
+				}
+			}
+			if repeater != "" {
+				words = append(words, repeater)
+			}
+			words = append(words, word)
+			run -= n
+			i += n
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+func isNumeral(m match) bool {
+	return m.value != "" && '0' <= m.value[0] && m.value[0] <= '9'
+}
+
+func onlyDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if '0' <= r && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// isWhole tells if the match isn't just a part of a longer word
+func isWhole(words string, m match) bool {
+	before, _ := utf8.DecodeLastRuneInString(words[:m.start])
+	after, _ := utf8.DecodeRuneInString(words[m.end:])
+	return !unicode.IsLetter(before) && !unicode.IsLetter(after)
+}
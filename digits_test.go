@@ -0,0 +1,51 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConverter_Words2Digits(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c     *Converter
+		words string
+		want  string
+	}{
+		{en, "four one five, double five, triple zero", "41555000"},
+		{en, "oh seven oh", "070"},
+		{en, "phone number one two three", "123"},
+		{en, "forty-two seven", "427"},
+		{en, "one eight hundred", "1800"},
+		{en, "account 0070 double one", "007011"},
+		{sv, "noll sju noll, dubbel fem", "07055"},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := tt.c.Words2Digits(tt.words); got != tt.want {
+				t.Errorf("Converter.Words2Digits(%s) = %v, want %v", tt.words, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConverter_Digits2Words(t *testing.T) {
+	c, _ := NewConverter("en")
+	tests := []struct {
+		digits string
+		want   string
+	}{
+		{"4155000", "four one double five triple oh"},
+		{"070", "oh seven oh"},
+		{"5555", "triple five five"},
+		{"+46 70-12", "four six seven oh one two"},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := c.Digits2Words(tt.digits); got != tt.want {
+				t.Errorf("Converter.Digits2Words(%s) = %v, want %v", tt.digits, got, tt.want)
+			}
+		})
+	}
+}
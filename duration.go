@@ -0,0 +1,169 @@
+package word2number
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Duration is a period of time, "two years and six months". Weeks count as seven days.
+type Duration struct {
+	Years  float64
+	Months float64
+	Days   float64
+	Hours  float64
+}
+
+// ISO writes the duration as an ISO 8601 period: P2Y6M, PT48H
+func (d Duration) ISO() string {
+	iso := "P"
+	for _, part := range []struct {
+		value float64
+		unit  string
+	}{{d.Years, "Y"}, {d.Months, "M"}, {d.Days, "D"}} {
+		if part.value != 0 {
+			iso += strconv.FormatFloat(part.value, 'f', -1, 64) + part.unit
+		}
+	}
+	if d.Hours != 0 {
+		iso += "T" + strconv.FormatFloat(d.Hours, 'f', -1, 64) + "H"
+	}
+	if iso == "P" {
+		return "P0D"
+	}
+	return iso
+}
+
+type durationType struct {
+	pattern *regexp.Regexp
+	unit    string
+}
+
+func newDurationType(m map[string]string) durationType {
+	return durationType{wordPattern(m["word"]), m["unit"]}
+}
+
+var restatementPattern = regexp.MustCompile(`\(([^()]*)\)`)
+
+// Words2Duration finds the periods of time in words and adds them together,
+// "thirty (30) days", "a ninety-day period". It returns false when there is none.
+func (c *Converter) Words2Duration(words string) (Duration, bool) {
+	words = c.dropRestatements(words)
+	var units [][]int
+	for i, d := range c.durations {
+		for _, m := range d.pattern.FindAllStringIndex(words, -1) {
+			units = append(units, []int{m[0], m[1], i})
+		}
+	}
+	sort.Slice(units, func(i, j int) bool {
+		if units[i][0] != units[j][0] {
+			return units[i][0] < units[j][0]
+		}
+		return units[i][1] > units[j][1]
+	})
+	var duration Duration
+	found, last := false, 0
+	for _, u := range units {
+		if u[0] < last {
+			// The days in "business days"
+			continue
+		}
+		before := words[last:u[0]]
+		last = u[1]
+		n, ok := c.countBefore(before)
+		if !ok {
+			// Years without a number, or an article: a year
+			continue
+		}
+		found = true
+		duration.add(c.durations[u[2]].unit, n)
+	}
+	return duration, found
+}
+
+// add adds n of the unit, one of years, months, weeks, days and hours
+func (d *Duration) add(unit string, n float64) {
+	switch unit {
+	case "years":
+		d.Years += n
+	case "months":
+		d.Months += n
+	case "weeks":
+		d.Days += 7 * n
+	case "days":
+		d.Days += n
+	case "hours":
+		d.Hours += n
+	}
+}
+
+// countBefore reads how many of a unit the words in front of it give: "thirty",
+// "a", "half a" and "one and a half"
+func (c *Converter) countBefore(words string) (float64, bool) {
+	if rest, part, ok := c.trimHalf(words); ok {
+		rest, _ = trimLast(rest, c.articles)
+		for _, d := range c.decimals {
+			if d.weak {
+				rest, _ = trimLast(rest, []*regexp.Regexp{d.pattern})
+			}
+		}
+		if run := c.matchWords(rest).tail(rest); len(run) > 0 {
+			return getNumber(run) + part, true
+		}
+		return part, true
+	}
+	if run := c.matchWords(words).tail(words); len(run) > 0 {
+		return getNumber(run), true
+	}
+	return 1, c.endsWithArticle(words)
+}
+
+// trimHalf takes a fraction word like half off the end of words, with the article after
+// it in "half a year", and returns the rest and the part it is
+func (c *Converter) trimHalf(words string) (string, float64, bool) {
+	rest, _ := trimLast(words, c.articles)
+	for _, f := range c.fractions {
+		if f.weak {
+			// An ordinal too, the fifth year
+			continue
+		}
+		if rest, ok := trimLast(rest, []*regexp.Regexp{f.pattern}); ok {
+			return rest, 1 / f.value, true
+		}
+	}
+	return words, 0, false
+}
+
+// endsWithArticle tells if the last word of words is an article, "a" in "a year"
+func (c *Converter) endsWithArticle(words string) bool {
+	_, ok := trimLast(words, c.articles)
+	return ok
+}
+
+// trimLast takes the last word of words off if one of the patterns matches it
+func trimLast(words string, ps []*regexp.Regexp) (string, bool) {
+	words = strings.TrimRight(words, " -\t\n")
+	for _, p := range ps {
+		if ms := p.FindAllStringIndex(words, -1); len(ms) > 0 && ms[len(ms)-1][1] == len(words) {
+			return words[:ms[len(ms)-1][0]], true
+		}
+	}
+	return words, false
+}
+
+// dropRestatements blanks out a number in parentheses repeating the number in front of it,
+// the (30) in "thirty (30) days"
+func (c *Converter) dropRestatements(words string) string {
+	for _, m := range restatementPattern.FindAllStringSubmatchIndex(words, -1) {
+		before, inner := words[:m[0]], words[m[2]:m[3]]
+		run := c.matchWords(before).tail(before)
+		again := c.matchWords(inner)
+		if len(run) == 0 || len(again) == 0 || len(again.head(inner)) < len(again) ||
+			strings.TrimSpace(inner[again[len(again)-1].end:]) != "" || getNumber(run) != getNumber(again) {
+			continue
+		}
+		words = words[:m[0]] + strings.Repeat(" ", m[1]-m[0]) + words[m[1]:]
+	}
+	return words
+}
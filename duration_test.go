@@ -0,0 +1,49 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConverter_Words2Duration(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c      *Converter
+		words  string
+		want   Duration
+		iso    string
+		wantOk bool
+	}{
+		{en, "thirty (30) days", Duration{Days: 30}, "P30D", true},
+		{en, "two years and six months", Duration{Years: 2, Months: 6}, "P2Y6M", true},
+		{en, "a ninety-day period", Duration{Days: 90}, "P90D", true},
+		{en, "48 hours", Duration{Hours: 48}, "PT48H", true},
+		{en, "a year", Duration{Years: 1}, "P1Y", true},
+		{en, "half a year", Duration{Years: 0.5}, "P0.5Y", true},
+		{en, "one and a half years", Duration{Years: 1.5}, "P1.5Y", true},
+		{en, "three weeks", Duration{Days: 21}, "P21D", true},
+		{en, "one point five years", Duration{Years: 1.5}, "P1.5Y", true},
+		{en, "one year, two days and 12 hours", Duration{Years: 1, Days: 2, Hours: 12}, "P1Y2DT12H", true},
+		{en, "five business days", Duration{Days: 5}, "P5D", true},
+		{en, "at least five business days", Duration{Days: 5}, "P5D", true},
+		{en, "one business day", Duration{Days: 1}, "P1D", true},
+		{en, "for many years", Duration{}, "P0D", false},
+		{en, "five dollars", Duration{}, "P0D", false},
+		{sv, "sex månader", Duration{Months: 6}, "P6M", true},
+		{sv, "trettio (30) dagar", Duration{Days: 30}, "P30D", true},
+		{sv, "två år", Duration{Years: 2}, "P2Y", true},
+		{sv, "48 timmar", Duration{Hours: 48}, "PT48H", true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			got, ok := tt.c.Words2Duration(tt.words)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Converter.Words2Duration(%s) = %v, %v, want %v, %v", tt.words, got, ok, tt.want, tt.wantOk)
+			}
+			if iso := got.ISO(); iso != tt.iso {
+				t.Errorf("Duration.ISO() = %s, want %s", iso, tt.iso)
+			}
+		})
+	}
+}
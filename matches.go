@@ -1,5 +1,12 @@
 package word2number
 
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
 const (
 	none = iota
 	countKey
@@ -8,6 +15,16 @@ const (
 	decimalKey
 	weakDecimalKey
 	percentKey
+	weakMultiKey
+	articleKey
+	repeatKey
+	powerKey
+	timesKey
+	factorKey
+	suffixKey
+	pointsKey
+	goodsMultiKey
+	weakPowerKey
 )
 
 func newMatch(t int, m []int, words string, value float64, multipliable bool) match {
@@ -49,6 +66,10 @@ func (mas matches) splitOn() (before matches, after matches) {
 	for _, m := range mas {
 		switch m.tyype {
 		case decimalKey:
+			if split {
+				// Only the first decimal word splits, "one point two million dollars"
+				continue
+			}
 			split = true
 			// Reset potential weak split
 			weakSplit = false
@@ -76,6 +97,97 @@ func (mas matches) splitOn() (before matches, after matches) {
 	return
 }
 
+func (mas matches) has(t int) bool {
+	for _, m := range mas {
+		if m.tyype == t {
+			return true
+		}
+	}
+	return false
+}
+
+// hasNumber tells if there is anything to count among the matches
+func (mas matches) hasNumber() bool {
+	return mas.has(countKey) || mas.has(multiKey)
+}
+
+// covers tells if one of the matches covers the span
+func (mas matches) covers(span []int) bool {
+	for _, m := range mas {
+		if m.start <= span[0] && span[1] <= m.end {
+			return true
+		}
+	}
+	return false
+}
+
+// percent returns the first percent following a number, the percent in "five percent"
+// but not in "percent of five"
+func (mas matches) percent() (match, bool) {
+	for i, m := range mas {
+		if (m.tyype == percentKey || m.tyype == pointsKey) && i > 0 && isCount(mas[i-1]) {
+			return m, true
+		}
+	}
+	return match{}, false
+}
+
+// only returns the matches of the given types
+func (mas matches) only(types ...int) (out matches) {
+	for _, m := range mas {
+		for _, t := range types {
+			if m.tyype == t {
+				out = append(out, m)
+				break
+			}
+		}
+	}
+	return
+}
+
+// around returns the matches next to the given place in words, and the ones next to those
+func (mas matches) around(words string, at []int) (out matches) {
+	span := match{start: at[0], end: at[1]}
+	for grown := true; grown; {
+		grown = false
+		for _, m := range mas {
+			if m.start < span.start && adjacent(words, m, span) {
+				span.start, grown = m.start, true
+			}
+			if m.end > span.end && adjacent(words, span, m) {
+				span.end, grown = m.end, true
+			}
+		}
+	}
+	for _, m := range mas {
+		if m.start >= span.start && m.end <= span.end {
+			out = append(out, m)
+		}
+	}
+	return
+}
+
+// trailingScale splits off the multipliers at the end and returns their product.
+// "two billion" gives "two" and 1e9.
+func (mas matches) trailingScale() (matches, float64) {
+	scale := 1.0
+	i := len(mas)
+	for i > 0 && mas[i-1].tyype == multiKey {
+		i--
+		scale *= mas[i].numeric
+	}
+	return mas[:i], scale
+}
+
+// trailingPercent splits off the percent at the end, the percent in "one point seven five percent"
+func (mas matches) trailingPercent() matches {
+	i := len(mas)
+	for i > 0 && (mas[i-1].tyype == percentKey || mas[i-1].tyype == pointsKey) {
+		i--
+	}
+	return mas[:i]
+}
+
 func (mas *matches) removeOverlaps() {
 	for i, a := range *mas {
 		for j, b := range *mas {
@@ -89,3 +201,166 @@ func (mas *matches) removeOverlaps() {
 		}
 	}
 }
+
+// resolveArticles keeps an article only in front of a multiplier, where it
+// counts as one: "a hundred". Weak multipliers are kept only after a number:
+// "ett par". The ones that need goods are kept only after a number above one,
+// "four score", or when they count something, "a couple of days". Anywhere else
+// they are ordinary words and dropped: "a gross breach", "a score of 5".
+// The matches need to be sorted.
+func (mas *matches) resolveArticles(words string, goods []*regexp.Regexp) {
+	var out matches
+	for i, m := range *mas {
+		switch m.tyype {
+		case articleKey:
+			if next := i + 1; next < len(*mas) && isMultiplier((*mas)[next]) && adjacent(words, m, (*mas)[next]) &&
+				((*mas)[next].tyype != goodsMultiKey || mas.counting(words, next, goods)) {
+				m.tyype = countKey
+				out = append(out, m)
+			}
+		case weakMultiKey, goodsMultiKey:
+			if n := len(out); n > 0 && out[n-1].tyype == countKey && adjacent(words, out[n-1], m) &&
+				(m.tyype == weakMultiKey || out[n-1].numeric > 1 || mas.counting(words, i, goods)) {
+				m.tyype = multiKey
+				out = append(out, m)
+			}
+		default:
+			out = append(out, m)
+		}
+	}
+	*mas = out
+}
+
+// counting tells if the weak multiplier at i counts the goods after it, "a gross of pencils",
+// and not a number, "a score of 5"
+func (mas matches) counting(words string, i int, goods []*regexp.Regexp) bool {
+	rest := words[mas[i].end:]
+	for _, g := range goods {
+		m := g.FindStringIndex(rest)
+		if m == nil || strings.TrimSpace(rest[:m[0]]) != "" {
+			continue
+		}
+		after := strings.TrimLeft(rest[m[1]:], " \t\n")
+		r, _ := utf8.DecodeRuneInString(after)
+		next := mas[i].end + len(rest) - len(after)
+		return unicode.IsLetter(r) && (i+1 == len(mas) || mas[i+1].start > next)
+	}
+	return false
+}
+
+// joinDividers makes a counter in front of a divider a part of it: "seven ten-thousandths".
+// That only happens when the counter can't continue the number before it,
+// so "seventy-seven thousandths" is still seventy-seven of them.
+// The matches need to be sorted.
+func (mas *matches) joinDividers(words string) {
+	var out matches
+	for _, m := range *mas {
+		if n := len(out); m.tyype == dividerKey && n > 1 {
+			first, prev := out[n-2], out[n-1]
+			if first.tyype == countKey && prev.tyype == countKey && first.numeric <= prev.numeric && adjacent(words, prev, m) {
+				m.numeric *= prev.numeric
+				m.start = prev.start
+				m.value = words[m.start:m.end]
+				out = out[:n-1]
+			}
+		}
+		out = append(out, m)
+	}
+	*mas = out
+}
+
+// resolveFactors turns multiplicative words into factors: "twice", "three times", "tenfold".
+// A suffix, or a times left over from the powers, takes the number in front of it as
+// its factor. The matches need to be sorted.
+func (mas *matches) resolveFactors(words string) {
+	var out matches
+	for _, m := range *mas {
+		if m.tyype != suffixKey && m.tyype != timesKey {
+			out = append(out, m)
+			continue
+		}
+		run, next := len(out), m
+		for run > 0 && (out[run-1].tyype == countKey || out[run-1].tyype == multiKey) && adjacent(words, out[run-1], next) {
+			run--
+			next = out[run]
+		}
+		if run < len(out) {
+			m = newMatch(factorKey, []int{out[run].start, m.end}, words, getValues(out[run:]), true)
+			out = out[:run]
+		} else if m.numeric == 0 {
+			continue
+		}
+		m.tyype = factorKey
+		out = append(out, m)
+	}
+	*mas = out
+}
+
+// tail returns the numbers at the very end of words, the matches of words.
+// A decimal point between counts is a part of the number: two point five.
+func (mas matches) tail(words string) matches {
+	i := len(mas)
+	for i > 0 && (isCount(mas[i-1]) || i < len(mas) && i > 1 && inDecimal(mas[i-2], mas[i-1])) &&
+		(i == len(mas) || joined(words, mas[i-1], mas[i])) {
+		i--
+	}
+	// Not a number that starts inside a word, the en in "den"
+	for i < len(mas) && (inWord(words, mas[i].start) || mas[i].tyype == decimalKey) {
+		i++
+	}
+	if i == len(mas) || strings.Trim(words[mas[len(mas)-1].end:], " -\t\n") != "" {
+		return nil
+	}
+	return mas[i:]
+}
+
+// head returns the numbers at the very start of words, the matches of words.
+// A decimal point between counts is a part of the number: two point five.
+func (mas matches) head(words string) matches {
+	i := 0
+	for i < len(mas) && (isCount(mas[i]) || i > 0 && i+1 < len(mas) && inDecimal(mas[i-1], mas[i])) &&
+		(i == 0 || joined(words, mas[i-1], mas[i])) {
+		i++
+	}
+	for i > 0 && mas[i-1].tyype == decimalKey {
+		i--
+	}
+	if i == 0 || strings.TrimSpace(words[:mas[0].start]) != "" {
+		return nil
+	}
+	return mas[:i]
+}
+
+// joined tells if b goes on with the number a is in. A spelled number after a numeral
+// is a number of its own: "in 2020 one in ten".
+func joined(words string, a, b match) bool {
+	return adjacent(words, a, b) && !(isNumeral(a) && b.tyype == countKey && !isNumeral(b))
+}
+
+// inDecimal tells if m is a decimal point right after the count before it
+func inDecimal(before, m match) bool {
+	return m.tyype == decimalKey && isCount(before)
+}
+
+// inWord tells if there is a letter right before pos
+func inWord(words string, pos int) bool {
+	r, _ := utf8.DecodeLastRuneInString(words[:pos])
+	return unicode.IsLetter(r)
+}
+
+func isMultiplier(m match) bool {
+	return m.tyype == multiKey || m.tyype == weakMultiKey || m.tyype == goodsMultiKey
+}
+
+// isCount tells if m is a part of a plain number, a count or a multiplier
+func isCount(m match) bool {
+	return m.tyype == countKey || m.tyype == multiKey
+}
+
+// adjacent tells if nothing but spaces and hyphens separate a and b
+func adjacent(words string, a, b match) bool {
+	if b.start <= a.end {
+		return true
+	}
+	return strings.Trim(words[a.end:b.start], " -\t\n") == ""
+}
@@ -0,0 +1,73 @@
+package word2number
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// minorUnits tells how many minor units there are in one of the currency, 100 cents in a dollar
+func (c *Converter) minorUnits(code string) float64 {
+	if d, ok := c.minorDigits[code]; ok {
+		return math.Pow10(d)
+	}
+	return 100
+}
+
+// newMinorDigits reads the number of decimals of the currencies with other than two
+func newMinorDigits(ms []map[string]string) map[string]int {
+	digits := make(map[string]int)
+	for _, m := range ms {
+		d, err := strconv.Atoi(m["digits"])
+		if err != nil {
+			panic(err)
+		}
+		digits[m["code"]] = d
+	}
+	return digits
+}
+
+// Money is an amount in a currency, given by its ISO 4217 code: {5000000 USD}
+type Money struct {
+	Amount   float64
+	Currency string
+}
+
+type currencyType struct {
+	pattern *regexp.Regexp
+	code    string
+}
+
+func newCurrencyType(m map[string]string) currencyType {
+	pattern := wordPattern(m["word"])
+	if m["attached"] == "true" {
+		// Written right after the numeral: 2mkr
+		pattern = regexp.MustCompile(`(?i)` + regexp.QuoteMeta(m["word"]) + `\b`)
+	}
+	return currencyType{pattern, m["code"]}
+}
+
+// Words2Money finds an amount of money in words. The currency can be a word, a symbol
+// or a code, in front of the amount or after it: "$5", "SEK 2,5 miljoner", "five US dollars".
+// The amount has to be next to the currency, "the dollar rose by five percent" is no money.
+// It returns false when there is no currency or no amount.
+func (c *Converter) Words2Money(words string) (Money, bool) {
+	i, found := first(words, len(c.currencies), func(i int) *regexp.Regexp { return c.currencies[i].pattern })
+	if found == nil {
+		return Money{}, false
+	}
+	money := Money{Currency: c.currencies[i].code}
+	if !c.matchWords(words[:found[0]]).hasNumber() {
+		// In front of the amount the currency is no decimal separator,
+		// the sum of Dollars One Million
+		words = words[:found[0]] + strings.Repeat(" ", found[1]-found[0]) + words[found[1]:]
+	}
+	ms := c.matchWords(words).around(words, found)
+	if !ms.hasNumber() {
+		return Money{}, false
+	}
+	units := c.minorUnits(money.Currency)
+	money.Amount = math.Round(getNumber(ms)*units) / units
+	return money, true
+}
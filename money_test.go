@@ -0,0 +1,53 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConverter_Words2Money(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c      *Converter
+		words  string
+		want   Money
+		wantOk bool
+	}{
+		{en, "$5", Money{5, "USD"}, true},
+		{en, "five US dollars", Money{5, "USD"}, true},
+		{en, "the sum of Dollars One Million", Money{1000000, "USD"}, true},
+		{en, "USD 2.5 million", Money{2500000, "USD"}, true},
+		{en, "€2.5M", Money{2500000, "EUR"}, true},
+		{en, "£300", Money{300, "GBP"}, true},
+		{en, "five dollars and fifty cents", Money{5.5, "USD"}, true},
+		{en, "one thousand euros", Money{1000, "EUR"}, true},
+		{en, "fifty cents", Money{0.5, "USD"}, true},
+		{en, "two pounds and fifty pence", Money{2.5, "GBP"}, true},
+		{en, "five Kuwaiti dinars and two hundred fils", Money{5.2, "KWD"}, true},
+		{en, "five dinars", Money{}, false},
+		{en, "¥99.6", Money{100, "JPY"}, true},
+		{en, "$2.499", Money{2.5, "USD"}, true},
+		{en, "KWD 1.5", Money{1.5, "KWD"}, true},
+		{en, "BHD 1.2345", Money{1.235, "BHD"}, true},
+		{en, "the dollar fell", Money{}, false},
+		{en, "the dollar rose by five percent", Money{}, false},
+		{en, "five hundred", Money{}, false},
+		{sv, "SEK 2,5 miljoner", Money{2500000, "SEK"}, true},
+		{sv, "500 kr", Money{500, "SEK"}, true},
+		{sv, "2mkr", Money{2000000, "SEK"}, true},
+		{sv, "3 mkr", Money{3000000, "SEK"}, true},
+		{sv, "tusen kronor", Money{1000, "SEK"}, true},
+		{sv, "€100", Money{100, "EUR"}, true},
+		{sv, "femtio öre", Money{0.5, "SEK"}, true},
+		{sv, "tio kronor och femtio öre", Money{10.5, "SEK"}, true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			got, ok := tt.c.Words2Money(tt.words)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Converter.Words2Money(%s) = %v, %v, want %v, %v", tt.words, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
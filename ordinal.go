@@ -0,0 +1,48 @@
+package word2number
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// ordinalPattern matches a numeral with one of the endings of ordinals: 21st, 3:e
+func ordinalPattern(endings []string) *regexp.Regexp {
+	if len(endings) == 0 {
+		return nil
+	}
+	return regexp.MustCompile(`(?i)\b(\d+)(?:` + quoteAll(endings) + `)\b`)
+}
+
+// ordinal finds the ordinal number in words, "twenty-first" or 21st. The number in
+// front of an ordinal word adds to it, or multiplies it when it's a scale: two hundredth.
+// It returns false if there is no ordinal or if there are other numbers in words.
+func (c *Converter) ordinal(words string) (float64, bool) {
+	if c.ordinalPattern != nil {
+		if m := c.ordinalPattern.FindStringSubmatchIndex(words); m != nil {
+			n, _ := strconv.Atoi(words[m[2]:m[3]])
+			if !c.matchWords(words[:m[0]]).hasNumber() && !c.matchWords(words[m[1]:]).hasNumber() {
+				return float64(n), true
+			}
+		}
+	}
+	for _, o := range c.ordinals {
+		m := o.pattern.FindStringIndex(words)
+		if m == nil {
+			continue
+		}
+		before := words[:m[0]]
+		ms := c.matchWords(before)
+		run := ms.tail(before)
+		if len(run) < len(ms.only(countKey, multiKey)) || c.matchWords(words[m[1]:]).hasNumber() {
+			continue
+		}
+		switch {
+		case len(run) == 0:
+			return o.value, true
+		case o.value >= 100:
+			return getValues(run) * o.value, true
+		}
+		return getValues(run) + o.value, true
+	}
+	return 0, false
+}
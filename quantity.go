@@ -0,0 +1,49 @@
+package word2number
+
+import (
+	"regexp"
+	"sort"
+)
+
+// Quantity is an amount in a unit of measure, "five thousand square feet" is {5000 ft2}
+type Quantity struct {
+	Value float64
+	Unit  string
+}
+
+type unitType struct {
+	pattern *regexp.Regexp
+	unit    string
+}
+
+// attachedPattern matches a numeral with a unit written right after it, 10kg,
+// and captures the unit
+func attachedPattern(units []string) *regexp.Regexp {
+	if len(units) == 0 {
+		return nil
+	}
+	// The longest first so kg isn't read as g
+	sort.Slice(units, func(i, j int) bool { return len(units[i]) > len(units[j]) })
+	return regexp.MustCompile(`(\d)(` + quoteAll(units) + `)(?:\b|$)`)
+}
+
+// Words2Quantity finds an amount with a unit of measure in words, "two hundred metric tons",
+// 10kg or "fem kvadratmeter", and gives the unit by its identifier. It returns false when
+// there is no unit with a number in front of it.
+func (c *Converter) Words2Quantity(words string) (Quantity, bool) {
+	if c.attachedPattern != nil {
+		words = c.attachedPattern.ReplaceAllString(words, "$1 $2")
+	}
+	i, found := first(words, len(c.units), func(i int) *regexp.Regexp { return c.units[i].pattern })
+	if found == nil {
+		return Quantity{}, false
+	}
+	quantity := Quantity{Unit: c.units[i].unit}
+	before := words[:found[0]]
+	run := c.matchWords(before).tail(before)
+	if len(run) == 0 {
+		return Quantity{}, false
+	}
+	quantity.Value = getNumber(run)
+	return quantity, true
+}
@@ -0,0 +1,42 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConverter_Words2Quantity(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c      *Converter
+		words  string
+		want   Quantity
+		wantOk bool
+	}{
+		{en, "five thousand square feet", Quantity{5000, "ft2"}, true},
+		{en, "two point five square feet", Quantity{2.5, "ft2"}, true},
+		{en, "10kg", Quantity{10, "kg"}, true},
+		{en, "10 kg", Quantity{10, "kg"}, true},
+		{en, "two hundred metric tons", Quantity{200, "t"}, true},
+		{en, "1,200 sq. ft.", Quantity{1200, "ft2"}, true},
+		{en, "a 5km radius", Quantity{5, "km"}, true},
+		{en, "2.5m", Quantity{2.5, "m"}, true},
+		{en, "2.5M", Quantity{}, false},
+		{en, "thirty acres of land", Quantity{30, "ac"}, true},
+		{en, "square feet", Quantity{}, false},
+		{en, "five dollars", Quantity{}, false},
+		{sv, "fem kvadratmeter", Quantity{5, "m2"}, true},
+		{sv, "femton ton", Quantity{15, "t"}, true},
+		{sv, "120 kvm", Quantity{120, "m2"}, true},
+		{sv, "3kg", Quantity{3, "kg"}, true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			got, ok := tt.c.Words2Quantity(tt.words)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Converter.Words2Quantity(%s) = %v, %v, want %v, %v", tt.words, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package word2number
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Range is an interval of numbers, like "between five and ten"
+type Range struct {
+	Min float64
+	Max float64
+}
+
+type rangeType struct {
+	pattern  *regexp.Regexp
+	start    *regexp.Regexp
+	numerals bool
+}
+
+func newRangeType(m map[string]string) (r rangeType) {
+	r.pattern = wordPattern(m["word"])
+	if m["start"] != "" {
+		r.start = wordPattern(m["start"])
+	}
+	r.numerals = m["numerals"] == "true"
+	return
+}
+
+// Words2Range finds a range in words and returns its ends. A scale or percent
+// after the second end applies to the first as well, so "twenty to thirty thousand"
+// is 20000 to 30000. It returns false when there is no range, or when the first end
+// is above the second, "five million to ten".
+func (c *Converter) Words2Range(words string) (Range, bool) {
+	words = c.dropRestatements(words)
+	counts := c.matchWords(words).only(countKey)
+	for _, r := range c.ranges {
+		for _, sep := range c.rangeSeparators(words, r) {
+			if counts.covers(sep) {
+				// The "to" in "ten to the power of six"
+				continue
+			}
+			left, right := c.matchWords(words[:sep[0]]), c.matchWords(words[sep[1]:])
+			if !left.hasNumber() || !right.hasNumber() {
+				continue
+			}
+			if r.numerals && !numeralsAround(words, sep, left, right) {
+				continue
+			}
+			counted := left.only(countKey)
+			if len(counted) > 0 && c.measured(words[counted[len(counted)-1].end:sep[0]]) && !c.measured(words[sep[1]:]) {
+				// The dollars in "five dollars to ten employees" are only on one side
+				continue
+			}
+			if rng, ok := getRange(left, right); ok {
+				return rng, true
+			}
+		}
+	}
+	return Range{}, false
+}
+
+// rangeSeparators returns where the separator of a range could be in words, last first
+func (c *Converter) rangeSeparators(words string, r rangeType) (seps [][]int) {
+	offset := 0
+	if r.start != nil {
+		start := r.start.FindStringIndex(words)
+		if start == nil {
+			return nil
+		}
+		offset = start[1]
+	}
+	for _, sep := range r.pattern.FindAllStringIndex(words[offset:], -1) {
+		seps = append([][]int{{sep[0] + offset, sep[1] + offset}}, seps...)
+	}
+	return
+}
+
+// measured tells if there is a currency or a unit of measure in words
+func (c *Converter) measured(words string) bool {
+	for _, cur := range c.currencies {
+		if cur.pattern.MatchString(words) {
+			return true
+		}
+	}
+	for _, u := range c.units {
+		if u.pattern.MatchString(words) {
+			return true
+		}
+	}
+	return false
+}
+
+// numeralsAround tells if the separator is right between two numerals, 5-10
+func numeralsAround(words string, sep []int, left, right matches) bool {
+	l, r := left[len(left)-1], right[0]
+	return isNumeral(l) && isNumeral(r) &&
+		strings.TrimSpace(words[l.end:sep[0]]) == "" &&
+		strings.TrimSpace(words[sep[1]:sep[1]+r.start]) == ""
+}
+
+func getRange(left, right matches) (Range, bool) {
+	min, max := getNumber(left), getNumber(right)
+	head, scale := right.only(countKey, multiKey).trailingScale()
+	if scale > 1 && !left.has(multiKey) && len(head) > 0 && min < getValues(head) {
+		min *= scale
+	}
+	if p := getPercent(right); p != 1 && getPercent(left) == 1 {
+		min /= p
+	}
+	if min > max {
+		return Range{}, false
+	}
+	return Range{Min: min, Max: max}, true
+}
@@ -0,0 +1,48 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConverter_Words2Range(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c      *Converter
+		words  string
+		want   Range
+		wantOk bool
+	}{
+		{en, "between five and ten days", Range{5, 10}, true},
+		{en, "between two hundred and fifty and three hundred", Range{250, 300}, true},
+		{en, "from 3 to 5 percent", Range{0.03, 0.05}, true},
+		{en, "twenty to thirty thousand dollars", Range{20000, 30000}, true},
+		{en, "five to ten million", Range{5000000, 10000000}, true},
+		{en, "fifty to one hundred", Range{50, 100}, true},
+		{en, "five hundred to two thousand", Range{500, 2000}, true},
+		{en, "5-10 percent", Range{0.05, 0.1}, true},
+		{en, "5 - 10", Range{5, 10}, true},
+		{en, "5-10%", Range{0.05, 0.1}, true},
+		{en, "five – ten", Range{5, 10}, true},
+		{en, "twenty-five", Range{}, false},
+		{en, "up to five million", Range{}, false},
+		{en, "two hundred and fifty", Range{}, false},
+		{en, "pay five dollars to ten employees", Range{}, false},
+		{en, "between five (5) and ten (10) days", Range{5, 10}, true},
+		{en, "five million to ten", Range{}, false},
+		{en, "deliver five kilograms to ten stores", Range{}, false},
+		{en, "five dollars to ten dollars", Range{5, 10}, true},
+		{sv, "mellan fem och tio dagar", Range{5, 10}, true},
+		{sv, "från 3 till 5 procent", Range{0.03, 0.05}, true},
+		{sv, "betala fem kronor till tio anställda", Range{}, false},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			got, ok := tt.c.Words2Range(tt.words)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Converter.Words2Range(%s) = %v, %v, want %v, %v", tt.words, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package word2number
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Rate is a percent over a period, on top of a base rate if there is one:
+// "five percent per annum" or "LIBOR plus two hundred basis points"
+type Rate struct {
+	Base   string
+	Value  float64
+	Period Duration
+}
+
+// Frequency is how many times something happens in a period: "twice a year"
+type Frequency struct {
+	Times  float64
+	Period Duration
+}
+
+type periodType struct {
+	word    string
+	pattern *regexp.Regexp
+	period  Duration
+	factor  bool
+}
+
+func newPeriodType(m map[string]string) (p periodType) {
+	p.word = m["word"]
+	p.pattern = wordPattern(m["word"])
+	n, err := strconv.ParseFloat(m["number"], 64)
+	if err != nil {
+		panic(err)
+	}
+	p.period.add(m["unit"], n)
+	p.factor = m["factor"] == "true"
+	return
+}
+
+// Words2Rate finds a rate in words, the percent with the period and base rate around it.
+// It returns false when there is no percent.
+func (c *Converter) Words2Rate(words string) (Rate, bool) {
+	ms := c.matchWords(words)
+	p, ok := ms.percent()
+	if !ok {
+		return Rate{}, false
+	}
+	before := words[:p.start]
+	rate := Rate{Value: getNumber(c.matchWords(before).tail(before)) / p.numeric}
+	if i, m := first(words, len(c.bases), func(i int) *regexp.Regexp { return c.bases[i].pattern }); m != nil {
+		rate.Base = c.bases[i].word
+	}
+	if period, m := c.firstPeriod(words); m != nil {
+		rate.Period = period.period
+	}
+	return rate, true
+}
+
+// Words2Frequency finds how often something happens in words: "payable quarterly",
+// "twice a year", "every two weeks". The period of a rate, "five percent per annum", is
+// no frequency. It returns false when there is no period.
+func (c *Converter) Words2Frequency(words string) (Frequency, bool) {
+	if _, m := first(words, len(c.every), func(i int) *regexp.Regexp { return c.every[i].pattern }); m != nil {
+		if d, ok := c.everyPeriod(words[m[1]:]); ok {
+			return Frequency{1, d}, true
+		}
+	}
+	period, m := c.firstPeriod(words)
+	if m == nil {
+		return Frequency{}, false
+	}
+	before := c.matchWords(words[:m[0]])
+	if n := len(before); n > 0 && (before[n-1].tyype == percentKey || before[n-1].tyype == pointsKey) &&
+		strings.TrimSpace(words[before[n-1].end:m[0]]) == "" {
+		return Frequency{}, false
+	}
+	times := 1.0
+	if factors := before.only(factorKey); len(factors) > 0 {
+		times = factors[len(factors)-1].numeric
+	} else if period.factor {
+		// Within a year, not a frequency
+		return Frequency{}, false
+	}
+	return Frequency{times, period.period}, true
+}
+
+// everyPeriod reads the period at the start of words after every: "two weeks", "month"
+func (c *Converter) everyPeriod(words string) (Duration, bool) {
+	var d Duration
+	var unit durationType
+	var at []int
+	for _, u := range c.durations {
+		if m := u.pattern.FindStringIndex(words); m != nil && (at == nil || m[0] < at[0]) {
+			unit, at = u, m
+		}
+	}
+	if at == nil {
+		return d, false
+	}
+	n := 1.0
+	before := words[:at[0]]
+	if run := c.matchWords(before).tail(before); len(run) > 0 {
+		n = getNumber(run)
+	} else if strings.TrimSpace(before) != "" {
+		return d, false
+	}
+	d.add(unit.unit, n)
+	return d, true
+}
+
+// firstPeriod finds the first period in words, and the longest if they start at the same place
+func (c *Converter) firstPeriod(words string) (periodType, []int) {
+	i, at := first(words, len(c.periods), func(i int) *regexp.Regexp { return c.periods[i].pattern })
+	if at == nil {
+		return periodType{}, nil
+	}
+	return c.periods[i], at
+}
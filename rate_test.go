@@ -0,0 +1,71 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConverter_Words2Rate(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c      *Converter
+		words  string
+		want   Rate
+		wantOk bool
+	}{
+		{en, "five percent per annum", Rate{"", 0.05, Duration{Years: 1}}, true},
+		{en, "two point five percent per annum", Rate{"", 0.025, Duration{Years: 1}}, true},
+		{en, "two percent a year", Rate{"", 0.02, Duration{Years: 1}}, true},
+		{en, "LIBOR plus two hundred basis points", Rate{"LIBOR", 0.02, Duration{}}, true},
+		{en, "2.5% per month", Rate{"", 0.025, Duration{Months: 1}}, true},
+		{en, "SOFR plus 1.5% per year", Rate{"SOFR", 0.015, Duration{Years: 1}}, true},
+		{en, "payable quarterly", Rate{}, false},
+		{sv, "fem procent per år", Rate{"", 0.05, Duration{Years: 1}}, true},
+		{sv, "STIBOR plus två procentenheter", Rate{"STIBOR", 0.02, Duration{}}, true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			got, ok := tt.c.Words2Rate(tt.words)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Converter.Words2Rate(%s) = %v, %v, want %v, %v", tt.words, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestConverter_Words2Frequency(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c      *Converter
+		words  string
+		want   Frequency
+		wantOk bool
+	}{
+		{en, "payable quarterly", Frequency{1, Duration{Months: 3}}, true},
+		{en, "twice a year", Frequency{2, Duration{Years: 1}}, true},
+		{en, "three times a month", Frequency{3, Duration{Months: 1}}, true},
+		{en, "once a year", Frequency{1, Duration{Years: 1}}, true},
+		{en, "payable once a month", Frequency{1, Duration{Months: 1}}, true},
+		{en, "every two weeks", Frequency{1, Duration{Days: 14}}, true},
+		{en, "every month", Frequency{1, Duration{Months: 1}}, true},
+		{en, "paid annually", Frequency{1, Duration{Years: 1}}, true},
+		{en, "within a year", Frequency{}, false},
+		{en, "every party", Frequency{}, false},
+		{en, "five percent per annum", Frequency{}, false},
+		{sv, "betalas kvartalsvis", Frequency{1, Duration{Months: 3}}, true},
+		{sv, "två gånger om året", Frequency{2, Duration{Years: 1}}, true},
+		{sv, "en gång om året", Frequency{1, Duration{Years: 1}}, true},
+		{sv, "varje månad", Frequency{1, Duration{Months: 1}}, true},
+		{sv, "fem procent per år", Frequency{}, false},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			got, ok := tt.c.Words2Frequency(tt.words)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Converter.Words2Frequency(%s) = %v, %v, want %v, %v", tt.words, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
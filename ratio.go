@@ -0,0 +1,63 @@
+package word2number
+
+import "regexp"
+
+// Ratio is a proportion between two numbers, like "three out of four" or "two-thirds"
+type Ratio struct {
+	Numerator   float64
+	Denominator float64
+}
+
+type ratioType struct {
+	pattern  *regexp.Regexp
+	numerals bool
+	dates    bool
+}
+
+func newRatioType(m map[string]string) ratioType {
+	return ratioType{wordPattern(m["word"]), m["numerals"] == "true", m["dates"] == "true"}
+}
+
+// Words2Ratio finds a ratio in words, either two numbers joined by a word like
+// "out of" or "in", 3:1, or a number in front of a fraction word, "two-thirds".
+// It returns false when there is no ratio.
+func (c *Converter) Words2Ratio(words string) (Ratio, bool) {
+	for _, r := range c.ratios {
+		for _, sep := range r.pattern.FindAllStringIndex(words, -1) {
+			before, after := words[:sep[0]], words[sep[1]:]
+			left := c.matchWords(before).tail(before)
+			right := c.matchWords(after).head(after)
+			if len(left) == 0 || len(right) == 0 {
+				continue
+			}
+			if r.numerals && !(isNumeral(left[len(left)-1]) && isNumeral(right[0])) {
+				continue
+			}
+			if r.dates && len(right) == 1 && isYear(right[0]) {
+				continue
+			}
+			if r.numerals && clockPattern.MatchString(words[left[len(left)-1].start:sep[1]+right[0].end]) {
+				// The time in "at 10:30"
+				continue
+			}
+			return Ratio{getNumber(left), getNumber(right)}, true
+		}
+	}
+	for _, f := range c.fractions {
+		for _, m := range f.pattern.FindAllStringIndex(words, -1) {
+			before := words[:m[0]]
+			left := c.matchWords(before).tail(before)
+			if len(left) == 0 || f.weak && getNumber(left) >= 10 {
+				// The ordinal in "twenty-fifth"
+				continue
+			}
+			return Ratio{getNumber(left), f.value}, true
+		}
+	}
+	return Ratio{}, false
+}
+
+// isYear tells if m looks like a year, a numeral of four digits like 2020
+func isYear(m match) bool {
+	return isNumeral(m) && len(m.value) == 4 && onlyDigits(m.value) == m.value
+}
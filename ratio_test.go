@@ -0,0 +1,44 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConverter_Words2Ratio(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c      *Converter
+		words  string
+		want   Ratio
+		wantOk bool
+	}{
+		{en, "three out of four directors", Ratio{3, 4}, true},
+		{en, "one in ten", Ratio{1, 10}, true},
+		{en, "a 3:1 ratio", Ratio{3, 1}, true},
+		{en, "two-thirds majority", Ratio{2, 3}, true},
+		{en, "one quarter of the shares", Ratio{1, 4}, true},
+		{en, "twenty-five out of one hundred", Ratio{25, 100}, true},
+		{en, "invested in 2020", Ratio{}, false},
+		{en, "paid 5 in 2020", Ratio{}, false},
+		{en, "one in 1,000", Ratio{1, 1000}, true},
+		{en, "the third party", Ratio{}, false},
+		{en, "at 10 : the meeting", Ratio{}, false},
+		{en, "at 10:30", Ratio{}, false},
+		{en, "a 16:9 screen", Ratio{16, 9}, true},
+		{en, "in 2020 one in ten", Ratio{1, 10}, true},
+		{sv, "tre av fyra ledamöter", Ratio{3, 4}, true},
+		{sv, "en på tio", Ratio{1, 10}, true},
+		{sv, "två tredjedelar av rösterna", Ratio{2, 3}, true},
+		{sv, "i förhållandet 3:1", Ratio{3, 1}, true},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			got, ok := tt.c.Words2Ratio(tt.words)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Converter.Words2Ratio(%s) = %v, %v, want %v, %v", tt.words, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
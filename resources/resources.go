@@ -69,7 +69,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _resourcesEnYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x95\xcb\xb2\xd3\x30\x0c\x86\xf7\xe7\x29\x34\xc3\x9a\x33\x2e\xe7\xda\x6c\x79\x12\xb7\x56\x88\xc1\xb5\x33\xbe\xb4\x1c\x9e\x9e\x49\x1b\x06\x74\x71\xdb\x21\xcb\xe8\xd3\x2f\xe9\x8f\xec\x60\x1c\x1e\x00\x1c\xee\xfd\xc1\x86\x32\xc0\x03\x00\xc0\x67\x38\xa5\xec\x06\x98\x93\x8f\xf5\xfc\x06\xe0\x84\xf6\xc7\x00\xa3\x0d\x05\x09\xe3\x52\x08\x36\x97\x1b\x14\xb6\x9c\x74\xe6\x13\x7c\x4d\x2d\x38\xd8\x21\xd8\x3f\x7d\x40\x99\x83\xaf\x15\xf3\x00\x29\x22\xd8\xe8\x60\xf4\x47\x84\x8a\xb1\x4e\xe5\x11\xfe\x49\xb3\xa1\x24\xf8\xde\x4a\xbd\x08\x8c\x3e\x04\xcc\x6b\xd1\x7a\x4a\x30\xb5\xe8\x32\xba\x55\x63\xac\x1f\x50\xa7\xd4\x8a\x8d\xee\x91\x34\x68\xa3\x23\xed\xd5\xdc\x70\xa9\xb3\x4f\x2d\x56\xcc\x65\x20\xf4\x2f\xcc\x69\xc5\x63\x3b\xec\x96\x46\x0d\x01\xd2\x74\x3d\x1c\x91\xc5\x37\x24\x5e\x4f\x5c\xfe\x0b\x8d\x4f\x19\xb9\xc2\x13\x21\xc6\xd4\x32\x03\x9e\x29\xe0\x8f\x5c\xe1\x85\x00\xc5\xff\x64\xf1\x57\x1a\xc7\x23\x46\x46\xbc\xd1\x8f\xee\xbf\x4d\x95\x11\xef\x84\x88\x5e\x18\xb1\xa5\x83\x8a\x12\x1b\xea\x24\x06\xa5\x8d\x0d\x77\x13\x83\x18\x76\xc3\x1d\xf5\xb9\xa2\x54\x92\xae\x6a\x14\xb7\x76\xd4\x20\x61\xaf\x06\x29\x1e\x6b\x98\x62\xb4\x42\x49\xb3\x35\x6a\xcb\xcd\x8a\xf5\x83\x6f\x9f\x91\x66\x71\xe6\xc9\x30\xab\x24\xf2\x6c\x84\x4f\x1c\x79\x31\xc2\x25\x8e\xbc\x1a\xc5\x23\x0e\xbd\x19\xe9\x10\x67\xde\x8d\xf4\x87\x33\xdb\x85\x39\xb4\x50\xfd\x1c\xbc\xb8\x07\xd6\xdb\x45\xac\x28\xb7\xeb\x72\xe3\x48\x8c\x72\x07\x1f\x82\x4f\x72\xe1\xcf\x0f\x21\x77\xd7\x48\x0e\xd7\x7c\x83\xbe\x24\xcc\x98\xf7\x18\x2b\x1d\x70\x7d\x79\x63\xc0\x19\x33\xdc\x89\x2d\x33\x8a\xa3\xc8\x3b\x9e\x31\xdf\xcb\x2d\x82\xf7\xc9\xe9\x94\xf3\x47\xef\xc4\x77\xbd\x32\xcc\xdf\x6d\xb0\xbb\x80\xda\xbf\x6e\x49\x2e\xff\x9d\x7d\xfe\xc7\xe9\x77\xde\x9d\xc9\xb2\xf6\x9d\xd9\xeb\x36\x2b\xe5\x8d\xce\xf5\xa6\xe4\x8b\xaf\x29\xf6\x48\x45\x53\x3d\x25\xaa\x66\x97\xd5\x54\x3b\x87\xaa\x27\xdc\xc5\x3b\xda\xbd\x63\x78\x45\xbf\x9f\xd2\xaf\xb1\xe4\xfc\x0e\x00\x00\xff\xff\xd0\xf1\xb7\x4d\xc5\x09\x00\x00")
+var _resourcesEnYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xad\x5c\xcd\x92\x1c\x37\x72\xbe\xfb\x29\x10\x4d\x39\xf6\xd2\x33\xd1\x33\xa3\x91\x96\x1d\xeb\x8d\x20\x45\x51\x22\x47\xc3\xe1\x72\x44\x91\x8a\xf0\x05\x5d\x85\xee\xc6\x4e\x55\xa1\x05\xa0\xa6\xd9\xab\x90\x43\xe1\x57\xf0\xc9\x67\xc7\xde\x7c\xf0\x4f\x84\xc3\x67\xee\x9b\xf0\x49\x9c\x09\x54\x55\x37\x7e\x0a\x55\x3d\x32\x0f\x24\xbb\xf2\xcb\x04\x90\x48\x20\x13\x89\x1f\x56\xcd\xff\x8e\x90\x9c\x65\xbc\xa4\x85\x9a\x13\xf8\x41\xc8\x09\xd9\x0a\x99\xcf\xc9\x46\xf0\x4a\x9b\x2f\x84\x6c\x19\xbd\x9b\x93\x25\x80\x98\x83\xc9\x45\x51\x50\xa9\x06\x50\xac\x96\x22\x8e\x79\x44\xbe\x12\x75\x91\x93\x05\x23\xb4\xad\x07\x51\x9b\x82\x6b\xcd\xe4\x9c\x88\x0a\xbe\x57\x39\x59\xf2\x7b\x46\x34\xab\xf4\x5a\x9d\x92\x03\x36\x90\x22\xc8\x9f\x6b\xa5\xad\x80\x25\x2f\x0a\x26\x9b\x42\xf5\x56\x90\x75\x5d\xe5\x92\xe5\x8d\x8c\xa5\xde\x11\xbd\x16\xb5\x82\x9f\xa7\x4e\x05\xe1\x83\x53\x3d\x2d\x6b\xd6\x94\xb3\xa6\x92\x66\x50\x19\x45\x56\x52\xd4\x1b\x5e\xad\x40\x06\xeb\xe4\x28\x23\x5b\xb1\x0d\xc0\x74\x4b\x6c\x15\x4a\x78\x45\xaa\xba\x64\xd2\x28\xf7\x6c\x7a\x3e\x9b\x4d\x67\xb3\xd9\xe9\xe5\x0c\x64\x37\x3c\x42\xaa\x79\x53\x17\xbd\xdb\xb0\xf9\x5e\x72\x53\x23\xb5\x2b\x17\xa2\x98\x93\xc9\x74\x32\x0e\xf7\x8f\x35\x94\xf3\xd8\x05\x37\x35\xf2\xa1\xa7\x13\xd3\xc8\x27\x8b\x85\x64\xf7\x9c\x6a\x50\x55\x49\x57\x15\xd7\x75\xce\xa0\x65\x4b\x68\xf7\x41\x03\x2e\xef\xa6\xe4\xb3\xf3\xd3\xcb\xeb\x29\x39\x9b\x91\xb2\xa8\x4e\xad\x8a\xa8\x62\xd0\x9a\x4a\x71\x8d\xdd\x04\x7d\xa6\xe0\xaf\x62\x47\x32\x51\x57\x9a\x6c\xb9\x5e\x1b\xad\x48\xbe\x5a\x6b\x92\x01\x7a\x4a\x2e\x4b\xc2\x95\xed\xd6\x92\xa1\x76\x51\x14\x6d\xab\xc1\x45\xd5\x29\xc5\x76\xd0\x5d\x53\x73\xa8\xcc\x02\x0d\xe3\x0c\xd4\xe8\x00\xae\x23\x80\x16\x43\x4c\xa1\x5d\x0d\x6d\xf7\xba\xdc\xbf\x8d\xbd\xac\x12\xec\x1d\xa8\x18\x83\x7a\x1a\xc7\x8c\xaf\xcb\xa2\x4a\x4b\x68\x71\xdf\xf7\xc2\xc6\x97\xa5\xab\x01\x21\xc6\x02\x98\xf4\x3a\xf3\x2f\x4c\x0a\x8f\xd1\xad\x9b\x58\xa7\xc9\x15\xf3\xcb\x75\xab\xb5\xf5\xc5\x9f\xbb\xf4\xb5\x64\xbe\x84\x0b\x07\xb1\x14\xb5\xf4\x00\x9f\xbb\x00\x50\x88\x07\xb8\x74\x00\x8a\x7f\xf0\xe8\x5f\xb8\x74\x76\xcf\x7c\xed\x7d\xe9\x4e\x9a\x38\x5c\x3c\xc4\xef\x1d\x44\xc5\x03\x45\x3c\x76\x1b\xca\xc2\x0e\x72\xcb\x28\x22\xd5\x38\xf3\xb5\xc9\x8a\xa0\xb1\x67\xbe\x46\xb9\xd4\x2c\x94\x14\x6a\x35\x86\xf2\x55\xbb\x8c\x81\x02\xf5\xc6\x40\x11\x1d\xc7\x60\x11\x45\x47\x50\xa1\xb2\x63\xa8\xc7\xbe\xb2\x2a\xbd\xf3\xad\x6f\x16\x2a\xcb\xc7\x5c\xcc\x3c\x55\x85\x90\xcf\x67\x81\x9e\x7c\xc8\xe5\x2c\xd0\x92\x0f\xf9\x62\x16\xd1\x91\x0f\xfa\x72\x16\x6a\xc8\xc7\xfc\x7e\x16\xea\xc7\xc7\x3c\x46\x4c\x59\x17\x9a\x83\x4b\x0f\xe6\x81\xc6\x3b\x87\x73\x88\xa7\x2e\xeb\xe7\x86\x3c\x40\x09\xce\x1f\x1c\xc7\x88\x39\x76\x91\x42\xfa\x60\x2d\x07\xd0\x96\x01\x23\x12\x08\x3e\x32\xe3\x02\x2b\x98\xf6\x14\xf2\xdf\x91\x82\xdf\x31\x47\x03\x26\x2e\xc9\xc5\x5f\x58\x35\x35\x03\x82\xa8\x4c\x48\x66\x3d\xe9\x3b\x08\x3e\xac\xff\xa4\x92\xc1\xe4\x59\x96\xa2\x32\xd5\x80\x0f\x20\x8f\x15\xc5\x14\xa3\x10\xa5\x48\xc5\x56\x05\x5f\xb1\x2a\x03\x5f\x8a\x01\xc8\x81\xbf\xb5\x5e\x9b\x36\x35\xb5\x82\xbf\x5f\x37\x7e\xd9\x38\xe3\x95\x10\x39\x8a\xc0\xc0\xa8\x81\x81\xef\x15\xd6\x77\x4f\x89\x90\xc6\x5d\x5b\x14\xfc\xaf\x11\x0c\x51\x52\x53\xb8\x58\x92\x0d\x14\xcd\x0b\xe3\xb5\xb3\xae\xdd\x5e\xf7\x9a\x46\xa6\xe7\x0d\xd3\xf6\xf8\x68\x39\x0c\xc5\x9a\x0f\xa6\x46\x11\x37\x64\x6a\x15\x4c\x2a\x9f\x1f\x2b\x06\x5a\xb9\x29\x58\xd4\x73\x1c\x21\x65\x43\xb9\x7c\x98\x0c\xe8\xff\x35\xd5\xd8\xed\xd0\x51\x0b\x06\x53\x09\xab\x40\xe7\xd9\x81\x5d\x41\x97\x61\x8c\x69\xbb\x06\xfb\xdd\x86\xa2\xf0\x0d\x62\x4d\x6d\xfb\x49\xf5\x75\x14\xc8\xe7\x38\x98\x34\x42\x91\xd1\x93\xac\x22\xd6\x80\x11\xec\x52\x0a\x30\x2b\x90\xc5\xf5\xdc\x33\x59\xdb\x00\xd7\x39\x2f\x6d\x48\x29\x35\xcf\x0a\x68\x48\x63\x94\xca\xda\xd6\x02\x4a\x37\xb6\x7a\x28\x97\x1e\x0c\x10\xac\x7c\x33\x2f\x4c\x71\x49\x80\x36\x64\x62\xc3\x46\x9e\x5b\x18\xf5\xa2\x78\x53\xf4\xb7\x62\x4b\x76\x0c\x56\x25\x66\x14\x29\xca\x41\x12\x36\x77\xc9\x25\x2c\x14\x64\x5d\x60\xcb\xef\x99\x6c\xa3\x75\xc4\x62\x34\x5a\x2b\x96\x5b\x3d\x65\x54\x82\x9e\x69\x61\x07\x6b\x3b\x01\x19\xa7\x3f\x35\x1d\xac\xf6\xfe\xa0\x99\x1c\x4f\x50\x31\xd3\xb6\xea\x87\xf4\x76\x0d\x72\x80\x83\x42\x4c\x05\xdb\xc6\x80\x26\x4a\x34\xfc\x2e\xf0\xd2\xc2\xfc\x7c\xdc\xc6\xeb\x7a\x57\x40\xfc\xd5\x56\xcb\xe1\x3a\x9b\x9d\x1d\x72\x3d\x86\x3f\x2e\x97\xa9\x6f\xf3\x09\x23\xaf\x26\xbc\x02\x6b\x30\x13\x8b\x46\x9b\xa3\x04\x64\xd1\x6e\xb1\x02\x25\x41\xf7\x80\x75\x88\xed\xbc\xed\x49\xf2\xfe\xc5\x0f\xc0\xb6\x66\x14\x4d\xd0\xef\x07\x8b\xf1\x3c\x4b\xa6\xdb\x79\x73\x3f\xde\xd7\x2c\xaf\x3d\x20\xfb\xb0\xe6\x0b\xae\xbd\x51\x24\xdd\x0f\xd9\x9a\x6e\x60\x5e\xf3\xfa\xbb\x62\x1f\xdc\x2f\x1b\xb0\xf7\x9c\xbb\x1f\xb3\x82\xd6\xde\x82\x54\x73\xed\x55\x62\x21\xc4\x9d\xf3\xe1\x5e\x14\xa0\x0c\xbf\x52\x74\x25\xe9\x66\xed\x7c\xe5\x9a\x95\x07\xfa\x6c\x07\xae\x19\x99\x60\x3d\x0c\xd7\x88\xc6\xc8\x25\xad\x56\x6c\xde\xac\x65\x05\x06\x67\xa7\xe4\x16\x06\xbb\x1d\x10\x25\xbd\x63\x2d\xc8\x88\x6b\xe7\x71\x2c\x85\x08\x68\x17\xda\x2b\xd7\xd3\xae\x04\x23\xc8\x4c\x02\xe8\x4b\xcc\x32\xb4\x93\xd6\x62\xda\xc5\x1b\xac\xb8\x4e\xce\x66\x68\xdd\xa6\x00\xbf\xfb\x3a\xef\xaa\x34\x28\x7e\xde\xb2\xbb\x3a\x13\x7e\x10\x2d\xea\x95\xab\x8a\xc9\xa7\x5f\xff\x65\xe2\x7e\x39\x99\xec\x27\xc3\x66\x19\xd9\x4d\x77\x5f\xd5\x52\xe2\xf4\x84\xd3\x1d\xae\xcc\x19\x97\xe4\xc5\xed\x0d\xf9\xfc\xfc\xec\x4b\x18\xa2\x39\x0c\xb6\xad\xc4\x4c\x40\x65\xdc\x1f\xaa\x77\xda\xac\x5d\x15\x7a\x29\x84\x80\xbc\xcf\x2e\xa7\x56\x17\x6f\x6f\xdb\x7c\x84\xf1\x4b\x9d\xf4\x79\x24\x65\xd1\xae\x70\x40\xc4\x1c\x18\x9f\x25\xb2\x1a\x71\x4c\x57\xd8\x38\xd4\x90\xb0\x67\x49\xfa\xe4\xb3\x49\x9a\xfe\xf6\x76\x00\x81\x29\x18\x07\xf0\xf5\xdb\x37\xbd\x39\x9a\x38\xa2\xfd\xdd\x47\x9f\x7c\xfa\xe7\x7f\x9f\x24\x11\x1b\xf0\x05\xb9\x83\xf8\xe6\xe9\xeb\x10\xa1\x86\x21\x60\xa8\x4c\x16\x30\x22\x46\x48\x1b\x87\x6d\x7f\xf7\xd1\x27\x1f\xff\x6d\x92\x04\xec\xba\x60\xc7\xd2\x5f\xbe\xfe\xd1\xa1\xb7\xbf\xfb\xe8\x93\x8f\x7f\x9d\x24\x01\xb7\x5b\x0e\x3e\x7d\x09\x03\x38\x73\x70\x5f\x7d\xfb\xbc\x0f\xa7\x92\xc0\xf6\x77\x1f\xfd\x0e\x1c\x34\x75\x10\xb7\x5f\x5f\x05\x08\x21\x93\x90\xdb\x2d\xc4\x2c\x6a\x3d\x42\xd8\x21\x72\x48\x68\xf3\xbb\xbf\x5e\x49\xf2\x33\x5a\xb5\x05\x31\x07\xf8\xec\xaa\x1f\x28\xd3\xc8\xab\xab\x24\xfd\x95\x90\x5b\xb6\xe2\xe0\x57\xc3\x52\x5f\xdd\x24\xb1\x32\x0d\xbe\xb9\x4a\xd2\xaf\xea\x2d\xe5\x9a\x13\x8c\x1c\x5c\x49\x57\xef\x9e\xf5\x23\x55\x1a\xfa\xee\x59\x92\xfe\x94\xae\x25\xe5\x55\xac\xd4\xa7\xdf\xa6\xa0\x2a\x8d\xfd\xf6\x59\x84\xfe\x88\x3c\x6b\xf3\xad\xe0\x67\x4d\x60\xbb\x77\x2c\x66\xc1\x23\xe0\x23\x2e\x6a\x40\xa3\xe0\x92\xa7\x26\x72\xea\x3c\xcd\x9c\x7c\xfc\x2b\xac\xe6\xa6\xd8\x08\x72\x76\x7a\x69\x82\xb0\x8d\x04\x91\xea\x30\x0f\xe9\xd6\x88\x40\x75\x57\x1c\x63\xed\x0b\x87\xfe\xe2\x4f\x69\xfa\xcb\x9b\x34\x7d\xaf\xd8\x38\xfd\xbb\x1f\xd3\xf4\x9b\xeb\x37\x49\xfa\xf7\xaf\xd2\xfc\x4f\x5f\x3c\xf7\xe8\x33\x87\xfe\xd5\x77\xaf\x93\xf4\x67\x2f\xd3\xfc\xdf\xbc\x4a\xd3\x5f\xdc\x5e\x25\xe9\xfb\x49\x34\x4e\xbf\xba\x4e\xcb\xbf\x7a\xf3\x2e\x49\x7f\xfd\xe3\x37\x49\xfa\x9b\x77\x69\xf9\x6f\xbf\x79\x9f\xa6\xff\xf8\x22\x49\xff\x21\xe8\x1f\x8f\xfe\xf6\x87\x24\xfd\xfd\x93\x74\xfd\xde\xdf\x0c\xd0\x5f\xc7\xe8\x8f\xc8\x35\x87\x29\x99\xd4\x15\x7c\xc2\x41\xb6\x1f\x60\x10\xa2\xe1\x02\xc2\xa4\x09\x72\x7e\xcf\x73\x76\x38\x02\x77\x18\xd8\xd9\x05\xad\x19\x73\xc8\x6e\x0b\xb2\xbb\x31\x19\x83\xf5\xea\xd4\xd9\xaa\x59\xc2\x6a\x15\x93\x46\x58\xa0\x17\xb9\x21\x3a\x19\xe4\x18\x71\x49\x04\x84\xd1\xd5\x2e\x1d\x33\x60\x52\x65\x08\x51\x41\xcb\x93\x18\xac\x09\xbd\x77\x23\xae\xeb\xf7\xaf\x62\x18\x95\x04\x49\xb3\xa4\x49\xba\xe9\x46\x63\xfd\xb3\xf1\xdf\xfe\x47\xb2\xa4\x3f\xfc\xdb\xff\xca\x98\x1f\x7c\x44\xde\xb6\x1d\xae\x39\xac\x2c\x96\x60\x02\x79\x2d\x9b\xfd\x99\x26\x8f\x49\x72\xba\x53\xb8\x54\xb7\x09\xc0\x13\xf8\x09\x1a\x92\x5c\xe4\xa7\x26\xed\x84\x36\x83\xab\x6b\x4c\x26\x34\xb9\x01\xb3\xf6\x9d\x92\x52\xe0\x7e\x1e\x18\x10\x63\x77\xf0\x0f\xca\x31\xab\x18\x58\x6e\xdb\x08\x7e\x5f\x96\x17\x68\x75\x2e\x05\x85\xdb\x0f\x2a\x80\xa8\x01\x8c\x29\xde\xc1\xd8\x0a\x85\x20\x35\x84\xc2\x16\x38\x18\xd3\xa4\x00\xa2\x06\x30\xa0\x01\x07\x81\x1a\xf1\x01\x2a\x8d\x58\xd4\x0a\xba\x01\xc2\xbf\x41\x59\x87\xc8\x01\xa1\xeb\xfd\x6e\x88\x45\x98\x1e\x0a\x20\x2a\x8a\x79\x44\x5e\x51\x1c\xff\x8d\x6b\x6e\x3b\x7d\x9f\x90\x41\xc3\x40\xcb\x62\x34\x5b\x5b\x32\x9a\x8b\xb6\x19\xcb\x6e\x01\x28\x6a\x1c\xfa\x96\xdb\x35\x87\x97\xb4\xaa\xa9\xdc\x25\x77\x84\x9e\xb3\x85\x8c\x80\xdc\x64\xe4\x35\x95\xd9\x3a\xb9\x2d\xf4\x64\x23\x79\x91\xdc\x17\xba\xa6\xbb\xe4\xb6\xd0\xcb\x3a\xd8\xb2\xf9\xc2\x03\x14\xbb\xe4\xb6\xd0\x93\x7a\x55\xab\xf4\xbe\xd0\x2d\xdb\x68\x86\x84\xe4\xe6\xd0\x4d\xa6\x45\x88\xf1\x36\x88\x5e\x89\xfb\x98\x24\x6f\x8b\x08\xe2\xaf\x28\xea\xbc\xcb\xc7\x51\xb0\x2a\xcd\xb0\x63\xdb\x0e\xc5\x04\x60\x8d\x09\x6d\x1c\xf2\xc6\x87\xfc\x59\xf0\xca\x76\x3c\xd0\x55\x93\xd6\xde\x5b\x0e\x98\x65\xbb\xbf\x72\x62\x2c\x07\x67\x08\x10\xba\xcf\xa2\x09\x59\x52\xb0\xbc\x09\xa2\x7f\x06\xf8\x2f\xc8\x83\xec\x3f\x1b\xbb\xf9\x65\x4a\x7e\xc6\x69\xe0\x97\x76\x99\xd5\xed\xc0\xb7\x79\x8a\x36\x87\xd3\x86\x91\x85\xc8\xee\x9a\x29\x69\x6b\x5d\x9c\x64\x05\xb6\x03\xd3\x37\x00\x40\x1b\xc7\xb4\x10\x58\xb1\xc9\xc3\xc0\x57\xcc\xe2\x80\xef\xaa\x35\xba\xc6\x25\xff\xc0\xda\x46\x48\x23\x1f\xb9\x9a\x1c\x6b\x9b\x01\x9d\x76\x89\x5c\x14\xd7\xa4\x7d\xe0\x67\x69\xe7\xcf\x66\x90\x2c\xed\xf8\x88\x8f\x0c\x93\xee\xc0\xca\xba\x23\x63\x22\x7e\x67\xbe\xb6\xed\x35\x95\x87\xf9\x74\x4e\x84\xf9\xee\x81\x3f\xfd\xfa\xaf\xe3\xe1\xad\xbb\x3e\x1c\xf8\x69\xf9\xf4\xb4\x3c\x0d\x45\xd3\xd2\xcd\x44\x95\x43\x08\x5e\x35\xf3\x88\xac\xf6\x4b\xfa\x5e\xf4\x64\x13\x2d\x75\xe3\xa2\x36\xe5\x10\xa2\x29\xd5\x74\x4e\x25\xba\xdd\xa0\x21\x3c\x6e\xae\xc5\x6a\xe9\xa1\xd7\xb4\x58\x1a\x33\x0a\x81\xfb\x8f\x8d\x55\x05\x5b\x85\x3f\xc1\xc4\x86\x26\x33\x9a\xdf\xdb\x4a\xa5\xbf\x59\x42\x9a\xaf\x2b\x67\xa9\xbb\x19\x22\x01\x6b\x2b\xa3\x45\x80\xed\x3e\x0d\x37\xe5\x21\xdc\xfd\x3c\xdd\x1e\x67\xac\xe7\x2b\x70\x6f\x6d\x52\xcb\x4e\x08\xde\x26\x57\xc9\xf3\xbd\x37\x3e\x8e\xaf\x3a\x38\x03\x90\xe2\x9c\xb9\xd1\x5a\xc9\xa8\xaa\x25\xdb\x67\x55\x21\x2c\xaf\x34\x5f\x9a\xfd\x15\x9b\x7f\x6e\xf7\x34\x14\x6a\x0c\x66\x19\xc6\xb4\x9d\x6f\xa8\xd6\x14\xb3\xf4\x66\xda\x6a\x76\x43\x2a\x3c\x5e\xd5\xce\x37\xf6\x18\x4f\x37\x4d\xb5\x89\xdd\xa9\xb5\x79\x98\xa0\xf0\xd4\x08\x3a\x92\xbb\x15\xce\x4b\x66\xcd\xe0\xce\x4b\x6d\x99\x42\x68\x27\x64\x58\xea\xf3\x28\x0e\xea\x36\x80\x83\x4f\x49\xc4\x44\xfd\x74\x0a\x1f\xbb\x99\xa0\x4f\x4e\x8f\x18\xd2\x69\x25\xb2\xe1\xb7\xd4\x1f\xff\xeb\x78\xae\xa6\x6d\xe6\xf0\x93\x1b\x5e\x9e\xf7\xe2\xd4\x38\x60\x17\xcb\x0f\xe2\x06\x04\x92\x32\x4d\x8f\x92\x93\xad\x2e\x3d\x55\x8d\xe2\x39\x3f\x9a\x25\xab\x17\x3c\x8b\xe9\xf6\xa2\x0f\xa6\x46\xe1\x7c\xcd\xf6\xc2\x92\xe2\xca\x8f\xff\x1d\x23\xa7\x95\x70\x71\x34\x0b\xcd\xbc\xea\xd2\x2c\xa0\xab\x14\x60\xcd\x32\x4d\x3d\x19\x6b\x1a\x83\xa8\x24\x86\xc6\xa8\xe9\x21\x15\xce\x0c\x2e\x3d\x9c\x11\xbc\x21\x19\xa3\x26\x8b\xe4\x55\xe6\x2e\x06\x79\x15\xd0\xbd\x76\x7a\x88\x92\x17\x9e\x79\xf0\x80\xae\x92\x80\xd0\x5e\x43\xba\x4a\x03\x7c\x0b\x0d\xe9\x49\x01\x11\x5a\x52\x6b\x77\xbc\x10\x61\xb5\xef\xca\x38\x48\x8d\x40\x79\x0d\xe8\x03\xa5\x45\x95\x31\x6a\x7a\xc6\x40\xff\x18\xb6\x24\x2b\x7b\x50\x6a\x0c\xcc\x6b\x4b\x2f\x2a\x2d\xac\x8c\x51\xd3\x93\x05\x9e\x58\x8a\x58\x53\xd9\x83\x52\x63\x60\xbe\x65\xf5\xa1\xd2\xc2\xca\x18\x35\xdd\x18\x10\x0a\xd3\xaa\xee\x22\x2f\xcb\xaa\x7b\x40\x2a\x81\x02\x72\xc5\x86\xe8\x03\x02\x5c\xaa\xf0\xb7\xc8\xfd\xf2\x3d\x00\xda\xef\x4a\x52\xcf\x3e\x57\x51\x8c\x1a\x02\x0d\xd1\xd3\x02\x56\x31\x6a\xb2\x27\x82\x8a\xaf\x02\xb2\x4a\xd1\x23\xb4\x64\x79\xc5\xc2\xe1\xe8\x7e\x26\x59\xd4\xf1\x3c\xdc\x1f\x28\x45\x48\x57\x69\x80\x37\x38\x22\xf4\xa4\x80\x08\x2d\xdd\x13\xb4\x28\x3c\x53\x5c\xd1\x22\x02\x51\x49\x0c\x1a\xc9\x16\x8a\x09\x13\x7c\x77\xef\xd6\xfd\x48\x95\x86\xbe\x5b\x47\xe9\x03\x83\x7c\x45\xe3\x35\xb9\xf6\xc4\x3b\x48\x95\x84\x5e\x7b\x35\xb9\xee\xaf\xc9\x23\xf2\xda\xa4\xac\xcd\xea\x49\x62\x52\xc9\x5e\x19\x91\xec\xa7\xba\x39\x43\x62\xd7\x4d\x1b\x26\x71\xde\xc6\x7f\xf1\xf8\x51\x5d\xe2\x79\xb0\x1d\x5d\x14\xac\x5d\x7a\x16\xbb\xfd\x91\xcb\x25\xcd\xb4\x90\xc1\x8d\x88\xf6\x64\x8f\x25\x63\x52\x8b\x67\x78\xf6\x07\x93\x53\xc8\x6c\xd3\xe7\xde\xa2\xa9\x2b\xb1\x27\xad\xdd\x97\xfa\x44\xbe\x44\xc6\xbc\x8f\xcd\x64\x9b\x1e\xc0\x87\x55\x04\xd3\xdb\x1d\xc9\x86\x88\xa3\x99\xe8\x31\x15\x24\x7b\x75\xfb\xd6\x37\x51\xac\xe4\x27\x6d\xcd\x27\x7d\xd9\xfe\xfe\xa3\xfc\x25\x8f\x36\x7b\x04\xef\x04\x53\x3f\x27\xb6\xf5\x47\x17\xdc\x59\xdc\x30\xe3\x45\x60\x13\x0d\xf3\xb1\xac\xc6\x2e\x1e\xc8\x4b\x8f\x65\x4c\xf4\x19\x36\x21\xb9\x83\x93\x1a\x0f\x25\xab\x14\x2b\x8f\xe5\xdc\x6f\x50\x1c\xcb\x69\x20\x63\xba\xc9\xb7\xef\xa3\x4a\x1b\xd0\x56\x62\x93\x2a\xd9\xe2\x07\xf0\x21\xc2\x6b\xee\x30\x13\x3d\xa6\xa4\x44\x5b\xf1\xea\x84\xc9\x9b\x8d\xa8\xc1\x79\xa0\xa5\xde\x7d\xb3\x94\x3d\xe5\xdc\xb3\xa6\x41\x2e\xa3\xd9\xa3\xcb\xca\x29\x2f\x8e\x64\xa1\xe3\x4b\x19\xb0\x9f\xc4\x2e\x60\x5f\xe1\x88\xf0\x2a\x3c\xcc\x44\xab\x63\x8a\x0a\x2a\xdd\xee\xe1\xb8\x07\xcc\xad\x4b\xb5\x87\x9d\xd7\x66\x4b\x1d\x3c\xfc\x8a\x02\x08\xfd\xbc\xf9\xdf\x1c\xb3\xf5\x72\x67\x0e\x23\xb4\xb6\x62\xbe\xb8\x7e\xd8\x7c\x0a\xfa\xd2\x14\xfc\x14\x2f\x42\x36\xd1\x03\x29\xa9\x5c\x81\x78\x0e\xff\xcf\x73\x96\x9b\xc3\xd9\xdf\xbd\x78\x7a\xf3\x86\x6c\x8a\x5a\x99\x52\x9a\x60\x02\x78\x17\x78\xd1\xce\x2d\xc7\x60\xdd\x6d\xbd\x9b\xe7\xfe\x87\x57\x2f\x9e\xf8\x47\x34\x43\xb6\xef\x83\x4f\x1b\x89\xdb\xf9\x58\x53\x77\x4b\xb8\xad\xbf\x97\x6c\xc9\xcd\x99\xf0\xa5\x39\x4b\xd9\x90\x1f\x91\xaf\x2b\x73\x0e\x1c\xd5\x2b\xec\xb1\xf4\xc3\xab\xae\x4a\x4f\xc9\x79\x95\x4f\xc9\x85\x84\xbf\xce\xcf\x4c\x92\x9f\x55\x91\xa3\xe3\x5e\xfa\xbf\x39\x1f\xda\x1d\x7f\xc8\xbd\x43\xc7\x89\x93\xd6\xd6\x30\xba\xc3\xd6\x9a\x8b\xe6\xaa\x1f\x6e\x91\xe1\x57\x7b\x44\xbf\xb9\xd5\x60\x0e\x4d\x5f\xcc\xcf\x6c\xb4\xf6\xc4\x1e\xb3\xb6\x87\xe1\xf1\x52\xb1\xd9\x59\x34\x87\xfd\x73\x72\x89\xf8\xf3\xd9\xf9\x6c\x4a\x72\xc1\x54\xf5\x3b\x6d\xf7\xf9\x6c\xfc\xd1\x84\x73\x5c\xdb\xd3\xd5\x50\xac\x7f\x23\xc2\x94\xee\x65\x93\xda\x53\x36\x66\x03\x33\x12\x92\xcc\x13\xe7\xa6\x9f\xe3\x8d\x64\xde\x5d\x0d\x82\xba\xb4\x57\x19\xbc\xad\xc5\x66\x23\xb3\xc4\xe3\xd3\xfb\x54\x7d\x13\x73\x8a\x13\x3c\xc0\x91\xab\x7d\xb8\xba\x75\xae\x1f\x35\xbd\x0a\x9c\x42\xec\xef\x18\x2d\x9b\xb2\x95\x77\xcf\x08\x7a\xa3\xc0\x4d\x52\x56\xd9\x5b\xdb\x78\xbe\x67\x3d\x25\x95\xd0\xfb\x3d\x5b\xf8\x82\x85\x75\x22\xe6\xc1\x26\x58\x72\x7a\x06\xc0\x3d\x53\x03\x37\x3b\xb9\xcc\x7b\x42\x09\xef\xf6\x8d\xc3\x92\x8e\x5b\xdc\xa8\x25\xbe\xf1\xdf\x60\x54\xfa\xd6\x28\x5e\x80\x5c\x47\x21\xbd\xf5\xb3\x3c\x03\x72\x51\xb5\xd1\x73\x07\xfd\x62\x91\x45\x0d\x5d\x61\x0d\xa4\x7e\x91\x96\x6a\x58\xd4\xf0\xc5\xd7\x40\xee\x97\x03\x72\x2d\x93\x1a\xbe\x30\xbb\x8e\x9e\x8c\xe8\x15\x6c\x79\xd4\xd0\x35\xdb\x40\xec\xe3\xb4\x58\xc3\xa2\x86\xee\xe6\x06\x52\xcf\x66\x03\xb6\x1a\x53\xc2\x99\xdd\xfc\xfb\x13\xac\x3f\xcc\x0e\x1f\x4c\x8c\x78\xec\x1d\xa7\x04\xda\x81\x70\x1c\x16\x78\xda\xc7\x1e\x82\x35\x27\xb7\xa6\xf6\x7e\x28\xde\xa4\x28\xbb\x2b\x88\xd7\x42\xd9\x2b\x68\x30\xa0\x21\x92\x62\x07\xd3\xca\xb4\x3d\x7a\xd0\x9c\xaa\x35\x13\x40\xf3\x56\x82\xe1\x38\x9c\x08\x4d\x1d\xbc\x11\xee\x54\xa2\x69\x85\xd8\xb4\x33\xd2\xe4\x8f\xff\x30\xf1\xe0\xc7\xa1\x35\xb8\xaa\x6d\x73\xce\x77\x94\xf4\x63\xe0\x14\xab\xbe\xdf\xe7\x4e\x21\x71\x83\x99\x97\x75\xd9\x4e\xfa\x49\xf4\x31\x58\x6c\x62\x69\xfb\x24\x56\xe5\x3f\x84\x2d\x3c\x0a\xad\xc9\x4a\x32\xaa\x7b\x55\x12\x91\x7f\x1c\x43\xbd\xd9\xef\xb1\x27\x60\x14\x5b\x19\xd3\x74\xac\xca\xec\x43\x66\x2f\x45\x8e\x83\x6b\xd1\x70\x8c\xa8\x06\x84\x70\x1f\x7a\xfb\xc6\x47\x1f\x85\xed\xef\x95\x3f\x4e\xbc\x9c\x6a\x4a\xbf\x1e\x18\xbc\x3e\xb6\xcd\xdc\xfb\x1c\x04\x27\xf4\xe6\x21\x13\x63\xf0\x0f\x13\x2f\x52\xec\x1f\x4f\x1e\x14\x42\x70\x29\x40\x63\xd0\xba\x6e\x81\x70\x80\xfe\xa7\x18\xfa\x74\x18\xb7\xb0\xc7\x04\x07\x50\xf2\xe0\x1a\x52\x3f\xcc\x5c\x6f\x1b\x51\xb9\x8c\xcb\x8c\x0e\xa2\x9a\x49\xb6\x7f\x90\x93\xc3\xf9\xd4\x67\x6d\xec\xe0\x81\xdc\xd8\x83\xfd\x56\x99\x64\x35\x5d\x3a\x9e\xf7\x11\x79\x2d\xb6\xe8\x82\xf0\xd6\x2d\x1a\xa4\x89\x50\x8d\xe3\x6a\xcf\xcc\x6d\x10\x80\x81\x29\x38\x9f\x69\x13\x9f\xe3\x3e\x98\x3a\x04\xb5\x2e\xd7\x80\x3d\x2f\xe2\xc9\x89\xd0\x62\x4e\xd4\x14\xe1\x09\xc2\x4f\xee\xc0\x6c\xaf\x21\xe7\x64\x81\x1d\x5f\xb1\x15\x8d\x5c\x6c\xc7\x03\x44\x2e\x63\x0b\x84\x8f\x6d\xd8\x3c\xf7\xc2\x2d\x19\x1c\xda\x3c\xf3\xaf\xcb\x8a\xe0\x91\x83\xb1\xd1\xed\x88\x00\x73\x38\x56\x1c\x8e\xfb\xc6\xc4\x70\x23\xa2\xb1\xe1\xc0\x6a\x44\x90\xe4\x3e\x61\x12\xa2\xc2\x47\x4c\xc2\x76\xf7\xbc\x62\x12\xe2\xe2\xef\x98\x84\xb8\xe8\x4b\x26\x21\x2c\xfa\x96\x49\x08\xeb\x79\xcd\x24\x04\xc6\xdf\x33\x09\x71\xf1\x17\x4d\x42\x5c\xe4\x4d\x13\xce\x02\x58\xec\x59\x93\x08\x2c\xf2\xb2\x49\x04\x15\x79\xdc\x24\x82\x8a\xbc\x6f\x12\x41\x45\x9f\x38\x89\xe0\x62\xaf\x9c\x44\x60\xb1\x87\x4e\x22\xb0\xc7\xb3\xd8\x01\xd9\x88\xf9\xc6\x9f\x37\x89\x01\xa3\x0f\x9c\x44\x81\xed\x23\x24\xd7\xcd\x0c\x96\x99\xe9\xa8\x9b\x7e\xcd\x06\x93\x39\x72\xcf\xd8\x29\x79\x42\x54\xbd\x5c\xf2\x0f\x26\x8b\xa1\xcc\xa5\x9c\x66\xa7\xca\x5c\xd2\xe9\x49\x24\x98\xfd\x6c\x56\x2d\x45\x61\x5e\x49\xb0\x1c\x7e\xaa\x63\x7f\x77\xa6\xef\x69\x26\x9e\xb1\xa1\xc7\x99\x42\xc8\x85\x77\x3d\xbb\x5e\x14\x03\x52\x24\x0f\xdf\xf2\x08\x56\xf5\xb9\x8c\xbc\xf8\xe1\xaf\xeb\x61\x92\x8a\xa0\x2e\x3d\xa3\x2e\xf2\xe8\x93\x55\xa4\x51\xf5\x81\x87\x7c\xc3\x36\xcc\xbc\xb4\x66\x6f\x58\x91\xed\xda\x3c\xf3\xa1\xec\x56\xa3\x39\x23\xaf\x36\xac\x28\xf0\x08\x66\xad\xdb\xd6\xda\xed\x47\xa0\x5d\x62\xc9\xd2\xca\x90\xc1\xd5\xf6\x87\x2b\xa6\x49\x46\x06\x9b\x8e\x07\x97\xae\xfa\x4c\x18\xb3\xc3\x23\x61\x68\xc3\x6c\xc8\xd0\x01\x38\x16\x87\x02\xc7\x89\x1b\x42\x4d\xfe\x7e\x32\x50\xff\xc9\xa7\x5f\xff\x63\x32\x28\xe6\xd3\xaf\xff\x19\x03\xcd\x82\xe7\x2d\x54\xa7\x90\x71\xaf\x16\x6d\xba\x53\xea\xb1\x81\xff\x1a\x1f\x6b\xb4\x89\xbb\x9c\x2f\x97\x0c\x6f\xdf\x1d\x3c\x23\xd3\x74\x24\x5d\x61\x52\x13\x17\x42\xb6\x06\x18\x86\xe1\x81\xaa\x75\xbb\x10\xf0\x3a\x1e\xf0\xce\x33\x90\xbe\x6a\x88\xa5\xaa\xf8\x96\x81\x23\x41\x1d\x2f\x62\x41\x15\x18\x7c\x4f\xf9\x47\xb1\xab\x07\xf1\x6f\x1e\xc6\x75\x44\x61\xf6\xc6\x64\x10\xe1\xa6\x52\x43\x6d\x8c\x8a\x67\x0e\x62\x6f\x6d\xf6\xe7\x88\xc6\x70\x8f\xf5\x5a\x1d\x4e\xfd\xbf\xb9\xb7\x3d\x52\xfd\x76\x4f\x18\xc1\xaa\xf1\x2f\x83\xf5\x09\xee\x85\xab\xe3\xde\x12\x4b\xc8\xef\x67\x51\x49\x9e\xff\x03\x01\xb8\xe2\x1f\xca\x55\x00\x00")
 
 func resourcesEnYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -84,12 +84,12 @@ func resourcesEnYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "resources/en.yml", size: 2501, mode: os.FileMode(420), modTime: time.Unix(1535037665, 0)}
+	info := bindataFileInfo{name: "resources/en.yml", size: 21962, mode: os.FileMode(420), modTime: time.Unix(1792388421, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _resourcesSvYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x95\x4f\x72\x23\x2b\x0c\xc6\xf7\x39\x85\xaa\xde\xfa\xa5\x70\xfe\x4f\x6f\xe7\x24\xd8\xc8\x63\x1c\x1a\xb9\x40\xb4\x27\x07\xca\x15\xe6\x02\xbe\xd8\x54\x3b\x4e\x66\x90\x54\x76\x32\xbd\xec\xef\x27\x21\x7d\x08\xa8\xd3\x70\x05\x10\x70\x15\x47\x9f\xea\x00\x57\x00\x00\xff\xc3\x9e\x4a\x18\xe0\x99\xc6\xd1\x1f\xff\x00\xec\xd1\x3f\x0f\xb0\xf6\xa9\x62\xcf\x14\xca\x54\x2e\x40\xd8\x0a\x5d\x40\x02\xa5\xe4\xed\x3c\xff\xc1\x77\x6a\x29\xc0\x12\xc1\xbf\x97\x0a\x75\x97\x22\x33\x96\x01\x28\x23\xf8\x1c\x60\x1d\x27\x04\xc6\xcc\x9b\x7a\x0d\x7f\x85\xf9\x54\x09\xb6\xad\xf2\x5b\x82\x75\x4c\x09\xcb\x69\x55\xde\x13\x6c\x5a\x0e\x05\xc3\x29\xc7\x9a\x5f\x80\x37\xd4\xaa\xcf\xe1\xba\xab\x90\x56\x9b\xae\x3c\x2e\x0d\xe7\x75\x56\xd4\x32\x63\xa9\x43\x47\x67\x4a\xe9\x84\xe7\x36\x2e\xe7\x42\x5d\xef\x09\xb3\xd0\x17\xbd\x9e\xcf\xca\x3c\x1d\x5e\x05\x70\xd3\x03\x05\x85\x7e\xdb\xe9\xeb\x97\xe2\x05\x70\xd7\x03\x38\x0a\xfd\xbe\xd3\x2b\xfe\x14\xfa\x43\xaf\x6f\x9b\xd0\x1f\x3b\xfd\xf0\xca\x2c\x2b\x78\xea\x3d\x8c\x24\xf4\x6f\x7d\x8b\x4a\x5f\x08\x8f\xd3\x24\x57\x58\x08\x1b\x29\x4d\x92\x50\x3e\x32\x93\xda\x0c\x61\xe6\x96\x8a\x01\x29\x43\x0d\x46\x99\x6a\x30\xca\x58\xab\xa2\xde\x5d\x6f\xd5\x23\xed\xb5\xd2\x08\x8b\xb7\xed\x87\x34\xf9\xc6\x69\x83\xd4\x4e\xdc\x3a\x39\x6d\x9a\xb9\x73\xca\x1f\xc5\xdc\x3b\xe5\x8f\x62\x1e\x9c\xf6\x47\x41\x8f\x4e\x4d\x9f\x62\x9e\x9c\x32\x48\x8f\xe0\xcc\x8c\x2d\x71\xdc\xa5\xa8\x8e\xfd\xf1\x32\x51\x33\xe7\x84\x63\xad\xea\xd3\xed\x04\x34\xc6\xb4\xd5\xbb\xe3\x8e\x9f\x02\x7d\x09\x36\x29\xe1\xe5\x99\xac\x1f\xf8\x0e\xcb\x0a\x33\xf7\x9d\xed\x0a\xcd\x3f\x2f\xb4\xb6\x2b\x34\xce\x17\xac\xdd\x5d\x88\x53\x0c\xca\xb3\xc3\x2f\x75\x55\xbd\xa7\xfd\xe3\xb4\x5f\x26\xb4\xde\x8d\x39\xd8\xe8\xe7\x93\xd1\x67\x3a\xfa\x5c\x70\xfd\xe7\x68\x8e\x94\x03\xca\x37\x62\xf1\xc5\xf0\x8f\x27\xf3\xcb\x09\xde\x26\xd5\xaa\xc0\xd9\x9c\xb1\x94\x31\xd6\x66\x42\x9b\xb3\x12\x5a\x67\x80\xd1\x4e\x7a\x86\x36\x53\xcf\xfc\xef\x00\x00\x00\xff\xff\xc7\x24\x3e\x01\xf0\x08\x00\x00")
+var _resourcesSvYml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\x9d\x5c\x4b\x93\x1b\xb7\x11\xbe\xe7\x57\xa0\xb8\xc9\x8d\xde\xe2\xae\xb4\x96\xc5\x72\x5c\xa5\x87\xad\x97\x25\xad\x77\x2d\x4b\xaa\xca\x05\xe4\x80\x24\x76\x1e\x60\x30\x18\xae\x36\x2e\xa7\x5c\xf9\x0b\xf9\x09\x91\x6e\x39\xe4\x51\xe5\xca\x59\xfb\x4f\xf4\x4b\xd2\x0d\x0c\x48\xe2\x31\x98\x59\x5d\x24\x71\xfa\xeb\x46\xa3\x01\x74\x37\x1a\x80\xea\xcd\xf4\x77\x84\x64\x6c\xce\x4b\x5a\xd4\x53\x02\x3f\x08\xf9\x82\x5c\x0a\x99\x4d\x49\x2e\xca\x92\xea\x2f\x84\x5c\x32\x9a\x4f\xc9\x02\x40\xcc\xc5\x48\x51\x09\xd9\x03\x62\x8d\x14\x3d\x90\x4c\x14\x05\x8d\xcb\x39\x20\x0f\x44\x53\x64\x64\xc6\x08\xb5\xaa\x92\x7a\x5d\x70\xa5\x98\x9c\x12\x51\xc1\xf7\x2a\x23\x0b\xbe\x61\x44\xb1\x4a\xad\xea\x43\xb2\xc7\x06\x52\x04\xb9\x68\x6a\x65\x04\x2c\x78\x51\x30\xd9\xb6\xaa\x2e\x05\x59\x35\x55\x26\x59\xd6\xca\x58\xa8\x2b\xa2\x56\xa2\xa9\xe1\xe7\xa1\xa3\xa1\x98\xaf\x1c\xf5\x94\x6c\x58\xdb\xce\x8a\x4a\x3a\x07\x65\x6a\xb2\x94\xa2\x59\xf3\x6a\x09\x32\xd8\x56\x4e\xad\x65\xd7\x6c\x0d\x30\x65\x89\xd6\xe6\x84\x57\xa4\x6a\x4a\x26\xb5\xfd\x8f\xc8\xf1\x64\x42\x26\x93\xc9\xf8\x64\x02\xb2\x5b\x1e\x21\xeb\x69\xab\x8b\xba\x5a\xb3\xe9\x4e\x72\xab\x51\x7d\x55\xce\x44\x31\x25\x23\x32\x1a\x86\xfb\x53\x33\x99\xd0\xc9\x60\x30\x28\x75\xf7\x06\xe0\xe3\x85\x0b\x6e\xfb\xea\x43\xc7\x23\x6d\xbe\x7b\xb3\x99\x64\x1b\x4e\x15\x0c\x42\x49\x97\x15\x57\x4d\xc6\xc0\x66\x0b\xb0\xe8\x9e\x69\x4e\x88\xca\xe5\x98\x1c\x8f\x4f\x48\x99\xe1\xbf\x8e\x26\xa4\xac\x0e\xcd\x08\xd0\x9a\x81\xb1\xaa\x9a\x2b\x9c\x05\x30\x25\x6a\xf8\xa3\xb8\x22\x73\xd1\x54\x8a\x5c\x72\xb5\xd2\x46\x97\x7c\xb9\x52\x64\x0e\x68\xe4\xa3\xb6\x61\x2e\xaa\xad\x81\xdb\x69\xdd\xea\x0a\xcd\xcf\x70\x92\x1d\xc1\x90\x38\x00\xd0\xa5\x0f\x52\x56\x11\x44\x00\x2a\x06\xa1\x78\x71\x31\x04\x16\x55\x2a\xd4\x6b\x18\x2c\x8b\x83\x42\x9c\x1c\x0a\xcc\x93\x48\x3d\x56\x4c\x7a\x23\x51\x81\x63\xf0\xb8\x5c\xb1\x4c\x29\x5f\xaa\x4b\xaf\x92\x64\xb5\xb9\xfe\xe0\x01\x8e\x5d\x80\x64\x1e\xfd\x96\x43\x5f\x5c\x49\xea\x01\x6e\xbb\x00\x56\x7a\xf4\x13\x87\x5e\xb3\x77\x1e\xfd\x4b\x97\x7e\xd1\x78\xf4\x3b\x0e\xfd\xfa\x83\x52\xbe\x06\x5f\xb9\x36\xe4\xc2\xa3\xdf\x75\xbb\x18\xd0\x8f\x3c\x1b\x17\x1b\xbf\x85\x23\xcf\x8c\xa2\xd8\xf8\x88\xc0\x8e\x4a\x89\x60\x30\x3c\x63\x5e\x08\x19\x01\x05\x06\x8d\x60\x02\xa3\x46\x30\x81\x61\x63\x1a\xb9\xd6\xa5\x31\x7d\x7c\xf3\xc6\xc4\x78\x26\xbe\x68\x96\xbe\x91\x8f\x27\xa1\x81\x82\x91\xb8\x35\xf1\x67\x5b\x88\xb9\x3d\x09\xec\x13\x60\x4e\x26\x81\x7d\x02\xcc\x97\x93\xd0\x3e\x01\xe8\xce\x24\x98\x7d\x01\xe6\xab\x49\x60\xa0\x70\x0a\x22\xa6\x6c\x0a\xc5\x21\x94\x07\xcb\x5e\x47\x65\x1a\xba\x0b\xd7\x62\x4d\xcd\xaa\x5e\x37\x0c\xbe\x53\x0c\x75\xb2\x54\x0e\xf4\x7a\xb3\x84\xd4\x2d\x1c\x53\x10\xc8\x36\xe6\x3a\x28\x55\xe0\xde\x6a\xe4\xce\x49\xc1\x73\xe6\x74\x5d\x7b\x21\x92\x35\x75\xcd\xab\x31\x7a\x34\x98\x2f\x62\x69\x62\xdb\x6b\xc8\x36\x4c\x44\xa3\x92\x81\x93\x2c\x4b\x51\x69\x25\xe0\x03\xc8\x63\x45\xa1\xd3\x8b\xbd\x70\x67\x22\x27\x6d\xd5\x32\x52\x7e\x5c\xb5\x61\x51\xc7\xc2\xa5\x10\xc0\x5e\x31\x4c\x7b\x5a\x18\x44\x43\x61\x42\x27\x66\x24\x0b\x48\xeb\x14\x11\x0b\x8c\x9a\x65\xab\x1e\xe4\x36\x75\x7d\xa8\x1d\xb5\xed\x94\x37\x68\xa6\x03\x3d\x6e\x00\x3a\x96\x5e\x07\xba\x9d\xc0\x03\xdc\x0e\x92\xaf\xf6\x83\xee\xcb\xde\x17\x2b\x66\x4d\x65\xd4\xaf\x7b\x22\x0e\xc8\x63\x71\x49\xae\x18\x95\xc6\xc0\x35\xe5\xd9\x58\x27\x0b\x0b\x2e\x21\x69\x94\x4d\x81\x56\xdf\x30\x69\x33\x37\xc4\x12\x5e\x13\x98\x7c\x99\x31\xee\x1c\xa6\x0d\xaf\x68\x61\x0c\xa5\x67\x25\xac\xc1\xb1\x4d\x2d\x6b\xeb\x22\xcc\xa4\x36\x2b\xa6\x0d\x1b\xba\x61\x6b\x45\xb0\x7a\x89\x9e\xb5\x9d\x6b\x04\xbc\x2a\x3a\x92\xbb\x77\x6d\xe6\xa4\xae\x0a\x36\xdd\x8a\xb5\x5f\x6d\x92\x08\x29\xd5\xc8\x91\xb4\x2f\xe6\x6e\x20\xc6\x6a\xdd\x21\x06\x66\x9e\x9e\x64\x6a\x45\x61\x4a\x11\x10\x48\xb7\x99\x2a\xf0\xc2\x1c\x81\x69\x20\x2e\xa7\xe4\x1e\x78\xa4\x39\x18\xe9\xcd\x93\x9f\x80\x6d\xc5\x28\x48\x5d\x7a\x33\x03\xbc\x28\xcc\xf9\xc2\xfd\xb6\xa9\xd1\x2c\xfe\xb2\xa2\x4b\xea\xa6\x62\x74\xcd\x95\xc7\x0a\xdf\xdc\x89\x17\x88\x86\x2f\x15\xa8\xe1\xcf\x08\xba\x94\x74\xe1\x7e\x6c\xaa\x5c\xed\x75\x77\xc6\xd4\x25\x63\x95\x49\xe0\x61\x7b\xc0\x30\x7f\x87\x85\x00\x16\xa0\xd5\x92\x69\xef\x0a\x01\x13\x56\x1d\x0c\xe2\x21\x39\x17\x25\x33\x6b\xaf\xa4\x39\xb3\x28\x2d\xcf\x2e\x43\x6c\x87\x88\x35\x43\x7d\x08\x57\x63\x52\xc2\x9a\x45\xfb\x81\x20\xd8\x54\xa0\x9c\xb1\xd9\x21\x6c\x65\x59\x25\x6c\xf6\x3b\x26\x27\x5f\x1c\x4d\x70\xb2\x69\xf1\x9e\x71\x77\x3b\x93\x5a\x81\xa1\xa7\x6d\x03\x5e\x88\x2f\x5c\x13\x8d\x3e\xfd\xfa\xf7\x91\xfb\xe5\x8b\xd1\x6e\xc1\xb4\x49\xf7\x76\x91\x3c\x68\xa4\x64\xd5\x9c\x83\x07\x99\xe1\x0e\x89\x71\x49\x9e\x9c\xbf\x24\xb7\x8f\x8f\xee\xc0\xf2\xc8\xd8\x98\x5c\x4a\xdc\x91\x55\xda\x2b\xa1\x29\xc7\x6d\xa6\x0f\xe6\x93\x1a\xa2\x93\x78\xcc\xdc\xcf\xbf\x7d\x66\xf2\x78\xed\x44\xf7\x5d\x14\x85\x54\x66\xbe\x62\x99\xf1\x55\x38\xcb\x60\xd3\x66\x05\x9b\xec\xdd\x98\x15\x87\xa7\x55\x13\x16\x36\x24\xbe\xda\x31\x6d\x95\x9c\x06\x9b\x54\x1b\x4b\x50\x91\x29\x6a\xd0\xbd\x8d\x8d\x43\xea\x0d\xec\x2f\x72\x3a\x04\x6a\x7f\x77\xb7\x96\x24\xab\x0e\x3a\xd9\x9a\x27\xe2\xee\xca\xcf\x62\xaa\x3e\x8b\x2b\xfb\x1c\xae\xbd\x22\x80\xe1\xfa\xf6\xd5\x99\x03\xb0\xbf\xbb\xe8\xa3\x4f\x7f\xfb\xe7\x28\x89\x70\x8a\x08\x06\xf2\xea\xfc\x61\x04\x52\x27\x31\x14\xa6\x15\xcf\xa9\x1e\xed\x01\x22\xed\xef\x2e\xfa\xe8\xf7\xa3\x34\xfd\xd5\x79\x0f\x02\x7c\x54\xe6\x00\x1e\xdd\x3f\x75\xdd\x26\xae\x10\x8e\xfa\xf6\x42\xed\xef\x2e\xfa\xe8\xe3\x3f\x46\x49\xc0\xd5\x36\xe5\x32\xf4\xa7\xa7\x6f\x1d\xba\xfd\xdd\x45\x1f\x7d\xfc\x30\x4a\x02\xea\xf9\xea\x92\xf1\xbf\x30\xb9\x00\x67\x37\x77\xa0\x0f\x1e\x7f\xe7\x40\xed\xef\x2e\x7a\x46\xbb\x96\xec\xc3\x67\xee\x9a\xb3\xbf\xbb\xe8\xc0\xdf\x21\xe9\xc5\x4b\x17\x69\x7f\x77\xd1\xf3\xe6\x92\x72\x33\x58\x18\x7e\x5d\x61\xcf\x5e\xbb\x23\x6f\x7f\x77\xd1\x67\x74\x25\x29\xaf\xe2\xc2\xee\x3f\x76\xc1\xf6\xb7\x4b\x3f\x20\x0f\x6d\x29\xca\xe4\x7b\x7b\x6e\xd4\x64\x8b\x02\x3e\xa2\xc7\x05\x6f\x0c\x11\x71\xac\x93\x8b\xad\xf3\xd7\x59\x2f\xf9\xf8\x61\x4c\x8e\xc6\x27\xf0\x2f\xa3\xe1\x5a\x82\xcc\x7a\xbf\xae\xe2\xaa\x44\x40\xdd\x25\x57\xf5\x6e\x33\x6d\xe8\x4f\x7e\x48\xd3\x9f\xbe\x4c\xd3\x77\xf6\x8a\xd3\xbf\x7f\x9b\xa6\xbf\x7c\x7e\x96\xa4\xff\xf8\x22\xcd\x7f\xff\xc9\x77\x1e\x7d\xe2\xd0\x1f\x7c\x7f\x9a\xa4\x3f\x7c\x9a\xe6\x7f\xf4\x22\x4d\x7f\x72\xfe\x2c\x49\xdf\x2d\xce\x38\xfd\xd9\xf3\xb4\xfc\x67\x67\xaf\x93\xf4\xd3\xb7\x8f\x92\xf4\xb3\xd7\x69\xf9\xaf\x1e\xbd\x49\xd3\xdf\x3e\x49\xd2\x7f\x0a\xc6\xc7\xa3\xbf\xfa\x29\x49\x7f\x73\x2f\xad\xdf\x9b\x97\x3d\xf4\xd3\x18\xfd\x80\x3c\xe7\xe0\x36\x48\x03\xf9\xae\x5e\x65\xbb\x15\x06\x69\x13\xe6\xd7\xb0\xc2\xae\x80\x65\xc3\x33\xb6\xbf\x04\xaf\x30\xd9\x82\x2d\x1f\xac\x44\xbd\xe8\x90\xdd\x34\x64\xf6\xf8\xe4\xfa\x37\x09\x99\x17\xee\x3a\xcc\xde\x02\xcb\xdb\x18\xdc\x4a\x6c\xcf\xcb\x82\x10\x9b\xcc\x3b\x10\x50\x25\x11\x73\x56\xa9\x64\x04\x46\x40\x9d\x44\x40\x1e\x3c\x67\xc9\x00\x23\xe9\x7a\xed\xe9\xe1\xfb\xf5\xb6\x97\xdd\x7e\xf1\xfa\x7f\x5e\x5f\x8d\x3f\x3f\x20\xaf\xec\x10\x28\x0e\xc9\xf6\x02\x06\x25\x6b\x64\x5b\x01\xb6\x15\x18\x08\x1b\x4b\x0a\x69\x6a\xcd\xde\x91\xf2\xfa\x43\x45\x33\x48\x51\x75\x7a\x8a\x03\x88\x5b\x3f\xdc\x25\x8b\x85\x16\xa8\x37\x70\x90\xd3\x0b\x3c\x78\x80\xd1\x64\x2c\x87\xbf\x32\x7a\x65\xaa\xfe\x2b\xd1\x48\xbd\x69\xde\x35\xe3\x95\x4f\xac\xcf\x46\xd9\x53\x23\xce\x47\xd4\x3d\x10\xa3\xa4\x03\x32\xfa\x44\x50\x4c\x0e\xc5\xd5\x7d\xc0\x0d\x9b\xe7\xd4\x01\xe9\xde\x07\x18\x21\x07\x81\xea\x1e\x14\x8c\x8a\x83\x40\x1b\xfb\x00\x2a\x07\x40\xea\x34\x06\x66\x46\xc9\x1c\x88\x1e\xc4\x00\xe3\xb5\xd5\x05\xaa\xa3\xa8\x03\xf2\x82\xe2\xb2\x6e\x43\xae\x9d\x3e\xbb\xba\x03\x4e\x31\x9c\x9e\x0c\xf2\x69\x43\xc6\x89\xa7\x4c\x19\x67\xbb\x25\x12\x0d\xae\x47\xc3\xed\x4e\xac\x0b\x5a\x35\x54\xf2\x64\xed\x7b\xc1\x66\x32\x02\x72\x0b\x36\x7b\x5d\x88\x17\xc0\xe9\x5a\xf2\x22\x59\x01\x2f\xe9\x45\xb2\x02\x7e\x01\xb6\x49\x96\xc0\x2f\x9a\x82\x27\x6b\xe0\xb4\x59\x36\xb5\xe2\xc9\x2a\x78\xcd\xd6\x8a\x21\x21\x59\x0b\x17\xb9\x12\x21\xc6\xab\x87\x57\x62\x13\x93\xe4\xd5\xc4\x33\x36\x8f\xa2\x8e\xb7\x65\x27\xc8\xda\xa8\x62\x38\xb0\x76\x40\xb1\xf6\xd6\x14\x85\x29\x08\xe8\xd0\x70\x21\x20\xbf\x33\xe5\x08\xd8\x80\xb6\xb5\xbe\xdd\xcc\x81\xe9\xdb\x56\x97\x17\xe0\xbf\x6b\x7d\x12\x80\x42\x77\x45\x25\x21\x4b\x0a\x33\x6f\x94\x81\xf8\x9f\x01\xfe\x0b\xf9\x59\x4f\x18\xf8\x1b\x5d\xc9\x2f\xa3\x9e\x02\x90\xcd\x0a\x0b\x31\xcf\x5b\x9f\x76\x69\x02\x96\x64\x05\xaa\xaf\x84\x06\xe0\xd4\xc6\x20\xb2\xc1\xaa\x07\xc0\x38\x51\x58\x12\x81\x50\xd4\x28\x0c\x74\x0b\xfe\xce\x6e\xec\xb5\x47\x38\xb0\x9b\x78\xac\x3e\xda\x9a\xa3\xe9\xb8\x15\xb7\xdb\xec\x97\x87\xe4\x31\x2d\x36\xe8\xa2\xd1\x5c\x2b\x5a\x2c\x00\x69\x40\xba\x7d\xc9\xd8\xae\x8c\xd0\x2e\xa1\x85\x59\x3d\xf1\x75\xa3\x0b\x06\xd8\x27\xaf\x56\x80\x9f\xa8\x0d\x3f\xba\x87\xe0\xb5\xa7\x98\xd1\x42\x07\xdc\x9d\x4c\x5e\x1c\x8e\x86\x00\xf3\x62\x08\x6a\x7d\xfd\x81\xe0\x18\x96\x3c\x03\x37\xc5\x42\x15\x68\xe9\xae\xde\xb2\x0f\x31\x5a\x1c\x96\x11\x0d\x3d\x14\xb6\xcb\xd0\xce\x9d\x0d\xaf\x5d\x06\x56\xf6\x21\x46\x2c\xda\xf0\x3a\x6c\x38\xdf\x5c\xbf\x2f\x8a\xfe\x36\xf3\x0d\x95\xca\x4c\xae\x10\x4a\x6b\x9b\x95\xb4\x93\x2d\x38\x0e\xea\x61\xdc\x9e\x3a\xd0\xed\x29\xd6\x0e\xa5\x84\x2f\xdc\x3b\x93\x31\xba\xf1\x01\x8c\x9e\x56\x9d\x2c\xbb\x23\x89\xac\xa2\xdb\xf3\xcd\x1d\xac\x82\xd8\x61\xe3\xbd\x59\x76\x13\x37\xbb\x29\x19\xad\x1b\x58\x2a\xdb\x5a\x1d\x24\x96\x95\xe2\x0b\x8e\x0e\x08\x0b\x8f\xa0\x33\xa4\x8b\xaa\x64\xca\xe6\x36\x4e\xe9\xad\x2d\x6b\x0f\x29\xbf\x8d\x71\xed\xaa\x15\xaf\xf5\xb9\x3a\xba\xca\x5c\x9f\x5c\xe8\x64\x77\xea\x1b\x6a\xdb\xa8\x9b\x5c\x1c\x7b\xb8\x32\x46\x4e\x17\xa5\x3e\xfe\xe7\xe6\x3c\xc7\x37\x66\xc9\x9b\x19\xcf\x23\x1d\xb8\xe5\x29\xf3\xdf\x18\x39\xad\xcc\xad\x1b\xb3\xac\x58\xae\xfc\x2c\x84\x7a\x13\x3a\x46\x4d\xeb\x11\x76\xce\xa5\x47\x68\x69\x93\xf1\x42\x84\x42\x73\x6f\x7d\x97\x31\x6a\x52\x2e\xee\x35\x78\x28\x78\xee\x0a\x9e\x97\x31\x6a\xda\x02\xbc\x28\x22\x82\x4b\xcf\x0e\x65\x8c\x9a\x14\xbc\x3b\x25\x36\x3c\xae\xf3\x51\x11\x5a\xaf\x61\x97\x92\x7a\x96\x5b\x06\x98\x24\x7d\x19\xa3\x26\x9b\x0d\x9a\x74\x25\x2e\x23\xb4\xa4\xbc\x82\xfb\x96\x76\x4f\x2a\x8a\x08\xad\xd7\x2e\x97\x40\x0f\xf2\xf8\xfc\xf5\xaa\x0b\xe8\x2d\xa3\x00\xf9\x7a\x15\xa5\xf7\xac\xa4\x25\x8d\xea\xf1\xdc\x93\xbe\x07\xf4\xf4\xf0\x91\xcf\x3d\x3d\x9e\x77\xeb\x71\x40\x4e\x99\xe4\xc2\x24\x70\x12\x33\x42\x73\xc5\x4d\xb2\x3f\x37\xed\x21\x89\x8e\x04\x6b\x29\x70\x25\xc1\xc6\x5c\xe2\x5e\x73\x8c\x87\x4f\xb4\xa0\xb5\x09\x6b\xb4\xa8\x37\xbc\xde\xcb\xac\xe8\x1c\x32\xc4\xe0\x86\x95\x3d\xeb\x32\x64\x7b\x60\x0c\x1b\xc9\x25\x7c\x16\x25\x0a\x66\x3a\xe3\x5a\x1b\x9d\xa6\x5e\x4d\x40\x26\x36\xc2\x5d\xbb\x17\xe0\x28\xf8\x2e\x5d\xb9\x01\x1b\x76\xe9\x86\x5c\xb6\x0f\x03\xd9\xc8\xce\x12\xc1\xb9\xb4\x4e\x8f\x01\x7e\x43\x0d\x30\x37\x89\xe9\xbe\xb7\x31\xef\xda\x3e\x61\x93\x2d\xfb\x4d\x59\xf7\x26\x41\x3f\xeb\xad\xa0\xd5\x96\xfd\xa6\xac\xdc\x32\x7a\x06\x4f\xb0\xf6\x58\x3c\x5d\x22\xe9\xb2\xb9\xe1\x1a\xd6\xf9\x08\xa7\x0a\xe7\xe7\x00\x56\x6e\x2b\x30\x83\x39\x7b\xfa\x9e\xaa\xd3\x74\x29\xa1\x4b\x32\x7e\xbf\xfb\xd9\xb8\x69\xac\x1a\xc8\xd6\xa3\x78\x67\xc5\xa7\xab\x79\x60\x08\x4d\xde\xcb\x05\x6b\x7b\x7f\xdf\xd3\xc3\xd5\xa3\x73\xaa\x7a\xd4\x6d\x35\xcd\x55\x0d\x64\x0b\x34\xb0\x3b\xf5\xfd\x8b\x3b\xb4\x75\xb5\xe6\xfe\xc6\x4a\xd7\x54\x21\x02\x2c\x29\x80\x30\x0e\xe8\x7f\xc1\x38\x53\x79\xc1\x76\xab\x83\xc1\x36\xe9\xca\x75\xce\x1a\xa1\x1b\xb9\x8f\xb7\x6c\xdb\x48\x82\x65\xa1\x25\x88\x82\xb4\x9f\x66\x19\xec\x1a\xf0\x8e\xc9\xf9\x8f\x4f\xee\xbf\x3c\x23\xeb\xa2\xa9\x4d\x04\x68\x63\x0b\xab\x56\x6d\x36\x35\x03\x11\x9e\xf3\x37\x4c\xfe\x49\x70\xf0\xed\xfb\xe0\x0b\xec\xa0\x99\x84\x4e\xc9\xeb\xf7\x95\xa2\x09\x92\x7b\x13\xe2\x8c\xe7\xf5\x8c\x56\x39\x9a\x23\x29\x62\x2d\x92\x9f\x2b\x6d\x93\x6f\x2b\x7d\xdb\x05\x2d\x2e\xcc\x75\x9a\xfd\xdb\xdc\x53\x3a\x26\xc7\xf8\xc7\xad\x29\x83\x7f\xc1\x6f\xb4\x71\x15\xb9\x20\x33\x9a\x52\xef\x36\xc6\x94\x8d\x12\xd7\x52\xcc\x8c\xd8\xde\x4c\x81\x6d\xa1\x2e\x5f\x13\xba\xd1\x17\x52\xc7\xd0\x8a\xde\x5f\xeb\xcb\x25\xb7\xa6\x47\xfa\xea\x08\xfc\xf0\xef\xe5\x6c\xfc\x1d\xb9\xa7\x44\xe2\x4a\xc8\x77\x78\xe9\x9d\x6f\x2f\xa3\x29\x9a\xdb\x1b\x52\x5e\x4d\xc7\xde\x23\xc3\x9b\x21\xbb\x8d\xe3\x2e\x4f\x00\xbd\xb3\x0b\x96\x31\x73\xd0\xbe\x68\xc5\xfa\x17\x02\x69\x70\xc1\xf4\x38\x00\xd0\x5e\x84\x90\x7d\x97\x7d\x8d\x2a\xc9\xf0\xe4\x2a\x9c\xb8\x1a\x7c\x71\xfd\x5e\x66\x11\x71\xb7\x3b\x60\x81\xbc\xf0\xe2\x6b\x44\xda\x49\x14\x14\xc8\xf2\x2e\xc8\x42\xa3\x2a\x26\xed\xcb\x0e\x58\x20\x2f\xb8\x4c\x5b\xc5\xba\x7a\x27\x8e\x0a\xa4\x85\x77\x9a\x45\x15\x4a\xfb\x2a\x8e\x0a\xa4\x05\xf7\x9f\x23\xb2\xee\xc6\x30\x54\xf6\xdd\x94\x8e\x48\xf2\xaa\xc3\xaa\x43\xd4\x91\x29\xd2\xfc\xd0\xd0\x42\x57\x62\x60\x55\x43\xf2\x8c\xce\x60\x7b\x0b\x13\x37\x9f\x55\xad\xec\x49\xd4\xb8\xbd\xa7\x4b\x98\x7e\xb4\x52\xda\xab\x52\xcf\x05\x60\xf0\x5c\x10\xfc\xc2\x42\x48\xb6\xb7\xea\xc6\xb6\xd6\xd9\x1e\xde\xeb\xcc\xbc\x7d\xad\xa2\x39\x4c\xaa\xce\x75\x36\xae\xdb\xf7\x16\x1a\x07\x7f\x8d\x5a\x64\x20\x16\x1c\x5d\xdb\x07\xb1\xb6\x4b\x76\xf4\xcd\x1f\x47\xde\x76\xb9\xda\xd6\xdf\x12\xb0\xe2\xfa\xfd\x72\x08\xce\xb4\x8f\x3b\x82\x58\xe3\x5f\xc7\xd0\x8b\x62\x28\x7c\x75\xfd\x5b\x54\x09\x1f\xd7\xac\xd7\xbb\x2b\x73\x49\x64\x49\xdf\xe1\xe5\x89\x01\x32\x6b\xc8\x33\x4a\x36\xa4\xf5\xce\xde\x7f\xe3\xe2\xba\xfb\xed\x01\x75\xf9\xb3\x56\x7c\x09\x91\x9f\xf5\xa2\x53\x83\xff\xb5\xa7\x01\x78\xae\x81\x50\x5c\xf8\x09\x1d\x3c\xf4\x9c\xcb\x6d\xce\xba\x87\xfa\xab\x87\xea\x87\x88\x32\x97\xf6\x22\x68\x0a\xd7\xc0\x56\x15\x7b\xd3\x0b\xdc\x2e\xc5\xee\xa9\x4c\xf6\x57\x5d\x84\x19\xc7\xed\xf3\xb9\xcd\xf0\x74\xcf\xa2\xde\xd6\xf5\x98\x0d\xe7\x3f\x20\xa7\xe2\x12\xbd\x15\x5e\x8d\xc6\xa0\xae\xe3\xbd\x76\x73\xb8\x4e\x60\x49\x5d\x28\x73\x23\x16\x9c\xd5\x58\x67\x21\x76\xe7\x1f\x42\xcc\x53\x94\xb5\x16\x38\xf5\x97\xdc\x0e\x17\xa3\x64\x96\x82\x55\x3f\x8f\xb9\x6d\x10\xbe\x55\x6c\x49\x23\x57\xd3\xb1\x04\x8f\xd9\x74\x9b\xa4\x79\xd4\xdd\xd1\x59\x77\x92\x4e\x23\x0f\x12\x62\xc9\xc3\x90\x8c\xa0\x3f\xca\x0f\x89\xdd\x03\xe2\xf1\xa0\x20\x3b\x20\x76\x0e\x08\x8a\x7d\x2f\x88\x16\x81\xc2\xe1\x13\xa2\x08\x26\xfa\x88\x28\x6c\x2d\xfa\x8c\x28\x84\xc5\x1e\x12\x85\xa8\xd8\x53\xa2\x10\x15\x7d\x4c\x14\xc2\x22\xcf\x89\x42\x50\xec\x41\x51\x88\x8a\x3c\x29\x0a\x51\xf1\x57\x45\x21\x2e\xfa\xb0\x28\x84\x45\xdf\x16\x85\xb0\xe8\xf3\xa2\x10\x16\x7f\x61\x14\x99\xa8\xb1\x47\x46\x91\xa9\x1a\x7b\x67\x14\x99\xae\x93\xc8\xdb\xa2\xc8\x8c\x8d\xbc\x2e\x8a\xa1\x62\xef\x8b\x14\xeb\x7c\x61\x04\xe9\x5a\xfb\xd4\x67\xae\x3d\x93\xf5\x9f\x59\x33\x9b\xb1\x42\x91\x1a\xb6\x40\xe5\xd5\x3c\x67\xea\x90\xdc\x23\x75\xb3\x58\xf0\x77\xfa\xe8\xbc\xd6\xf7\xbd\xda\x8a\xab\xbe\xff\xd5\xb1\xb9\xd2\xe7\x05\xe0\x7c\x40\x60\x41\x31\xaf\x33\x3c\x9e\x93\x83\x1d\xa1\x76\x93\xe9\x0a\x8a\x51\xaa\xe3\xe5\x8c\x51\x2e\x12\x59\x4c\xd3\x37\xe5\x82\x39\x17\x6f\xef\x76\x0c\x56\xf4\x3e\xb3\x8c\x0b\x3b\x89\xc1\x02\x61\x1e\x8a\x16\x19\x5f\x46\xdf\x9d\xa6\xfa\xa3\xb9\xd4\xe7\xb1\xd1\x61\x6c\x07\xe4\x8c\xad\x99\x7e\x2c\x6d\xae\x18\x92\xcb\x15\x3e\xba\x80\x25\xa7\xeb\xfa\xfa\x7a\x44\xbd\xc6\x50\x9f\xe1\x35\x07\x3b\xa4\xba\xd6\x0f\xa4\x13\xec\xa8\x34\x22\x64\xf0\x7e\x0b\x91\x3d\x31\x8e\xaf\xd7\xd1\xed\x31\x24\x12\x58\xeb\xf1\x2a\xfb\xa6\x00\xd4\xb3\xd2\x00\x85\x67\x6e\xbd\x4b\x6d\xf4\x87\x51\x8f\xa4\xd1\xa7\x5f\xff\x35\xea\x15\xf3\xe9\xd7\x7f\xc7\x40\x9e\x52\xeb\x32\xb1\xa4\x4f\x05\xec\x3c\xcc\x8b\xb1\x8c\x2f\x74\x15\x69\xce\x76\x65\x9a\xd6\x18\x74\x89\x17\x5f\x2a\xa1\xf0\xed\x91\x39\x99\xc7\x47\x3e\x6a\x15\x3c\x4d\xda\x2b\x94\x75\x74\x11\xb3\x26\x6c\x33\x56\x77\xf4\xcb\x6c\x37\xe4\x9f\xd1\xda\x3e\x83\x8a\xdb\x64\x00\x2f\x93\x9f\xc5\xbd\xfe\x3c\xae\x7a\x38\x9b\xb9\x55\xeb\x4f\xf5\xe4\x46\x7e\xf7\x2e\x95\xce\xf0\xa5\x5c\xf8\xdf\x55\x24\xb7\xf8\x43\x04\xd8\x18\x54\xf4\x4c\xe8\x2d\x8e\xca\x61\xe1\xaa\xe8\xfd\x9f\x0b\x5a\x1c\x95\x43\x23\x5b\x5c\x68\x02\x4d\xbb\xfe\x7f\x81\xff\x03\xda\x6a\x5b\x6e\x6f\x44\x00\x00")
 
 func resourcesSvYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -104,7 +104,7 @@ func resourcesSvYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "resources/sv.yml", size: 2288, mode: os.FileMode(420), modTime: time.Unix(1535037829, 0)}
+	info := bindataFileInfo{name: "resources/sv.yml", size: 17519, mode: os.FileMode(420), modTime: time.Unix(1792387963, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
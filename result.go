@@ -0,0 +1,79 @@
+package word2number
+
+// Kind tells what sort of number a Result is
+type Kind int
+
+const (
+	// Cardinal is a plain number: two hundred
+	Cardinal Kind = iota
+	// Multiplier is a factor to multiply something with: twice, three times, tenfold
+	Multiplier
+	// Percent is a part in hundreds, or thousands or millions: five percent, 15‰, 3 ppm
+	Percent
+	// PercentagePoints is a difference between percentages: two percentage points, 50 bps
+	PercentagePoints
+	// Monetary is an amount of money: five dollars, fifty cents
+	Monetary
+	// Fraction is a part of something: two-thirds, three out of four
+	Fraction
+	// Ordinal is a position in a sequence: the twenty-first, 3rd
+	Ordinal
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Cardinal:
+		return "cardinal"
+	case Multiplier:
+		return "multiplier"
+	case Percent:
+		return "percent"
+	case PercentagePoints:
+		return "percentage points"
+	case Monetary:
+		return "money"
+	case Fraction:
+		return "fraction"
+	case Ordinal:
+		return "ordinal"
+	}
+	return "unknown"
+}
+
+// Result is a number found in words together with its kind. Value is the number as
+// it's written, 250 in "250 percent", and Denominator what it is a part of, 100 for a percent.
+type Result struct {
+	Kind        Kind
+	Value       float64
+	Denominator float64
+}
+
+// Fraction is the value divided by its denominator, 2.5 for "250 percent".
+// Without a denominator it is the value itself.
+func (r Result) Fraction() float64 {
+	if r.Denominator == 0 {
+		return r.Value
+	}
+	return r.Value / r.Denominator
+}
+
+// Parse takes in a string and returns the number in it with its kind
+func (c *Converter) Parse(words string) Result {
+	ms := c.matchWords(words)
+	r := Result{Kind: Cardinal, Value: getNumber(ms), Denominator: 1}
+	if ms.has(factorKey) {
+		r.Kind = Multiplier
+	} else if ratio, ok := c.Words2Ratio(words); ok {
+		r = Result{Fraction, ratio.Numerator, ratio.Denominator}
+	} else if p, ok := ms.percent(); ok {
+		r = Result{Percent, getMagnitude(ms), p.numeric}
+		if p.tyype == pointsKey {
+			r.Kind = PercentagePoints
+		}
+	} else if n, ok := c.ordinal(words); ok {
+		r = Result{Ordinal, n, 1}
+	} else if m, ok := c.Words2Money(words); ok {
+		r = Result{Monetary, m.Amount, 1}
+	}
+	return r
+}
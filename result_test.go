@@ -0,0 +1,93 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConverter_Parse(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c     *Converter
+		words string
+		want  Result
+	}{
+		{en, "two hundred", Result{Cardinal, 200, 1}},
+		{en, "twice the fee", Result{Multiplier, 2, 1}},
+		{en, "three times the deposit", Result{Multiplier, 3, 1}},
+		{en, "a tenfold increase", Result{Multiplier, 10, 1}},
+		{en, "two-fold", Result{Multiplier, 2, 1}},
+		{en, "a hundredfold", Result{Multiplier, 100, 1}},
+		{en, "triple damages", Result{Multiplier, 3, 1}},
+		{en, "double the deposit", Result{Multiplier, 2, 1}},
+		{en, "double five", Result{Cardinal, 5, 1}},
+		{en, "triple 7", Result{Cardinal, 7, 1}},
+		{en, "three times ten to the ninth", Result{Cardinal, 3000000000, 1}},
+		{en, "the manifold", Result{Cardinal, 0, 1}},
+		{en, "2.5%", Result{Percent, 2.5, 100}},
+		{en, "15‰", Result{Percent, 15, 1000}},
+		{en, "five percent", Result{Percent, 5, 100}},
+		{en, "3 ppm", Result{Percent, 3, 1000000}},
+		{en, "two percentage points", Result{PercentagePoints, 2, 100}},
+		{en, "fifty basis points", Result{PercentagePoints, 50, 10000}},
+		{en, "50 bps", Result{PercentagePoints, 50, 10000}},
+		{en, "one basis point", Result{PercentagePoints, 1, 10000}},
+		{en, "two hundred fifty percent", Result{Percent, 250, 100}},
+		{en, "percent of five", Result{Cardinal, 5, 1}},
+		{en, "five dollars", Result{Monetary, 5, 1}},
+		{en, "$2.5 million", Result{Monetary, 2500000, 1}},
+		{en, "fifty cents", Result{Monetary, 0.5, 1}},
+		{en, "two-thirds", Result{Fraction, 2, 3}},
+		{en, "three out of four", Result{Fraction, 3, 4}},
+		{en, "the twenty-first", Result{Ordinal, 21, 1}},
+		{en, "the 3rd party", Result{Ordinal, 3, 1}},
+		{en, "the third", Result{Ordinal, 3, 1}},
+		{en, "twenty-fifth", Result{Ordinal, 25, 1}},
+		{en, "the twenty-fifth day", Result{Ordinal, 25, 1}},
+		{en, "one hundred fifth", Result{Ordinal, 105, 1}},
+		{en, "one fifth", Result{Fraction, 1, 5}},
+		{en, "two hundredth", Result{Ordinal, 200, 1}},
+		{sv, "dubbelt så mycket", Result{Multiplier, 2, 1}},
+		{sv, "tredubbla", Result{Multiplier, 3, 1}},
+		{sv, "fyrdubbla", Result{Multiplier, 4, 1}},
+		{sv, "femdubbelt", Result{Multiplier, 5, 1}},
+		{sv, "tiofaldig", Result{Multiplier, 10, 1}},
+		{sv, "tre gånger hyran", Result{Multiplier, 3, 1}},
+		{sv, "2,5 %", Result{Percent, 2.5, 100}},
+		{sv, "två procentenheter", Result{PercentagePoints, 2, 100}},
+		{sv, "femtio baspunkter", Result{PercentagePoints, 50, 10000}},
+		{sv, "femtio kronor", Result{Monetary, 50, 1}},
+		{sv, "två tredjedelar", Result{Fraction, 2, 3}},
+		{sv, "tredje stycket", Result{Ordinal, 3, 1}},
+		{sv, "21:a maj", Result{Ordinal, 21, 1}},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := tt.c.Parse(tt.words); got != tt.want {
+				t.Errorf("Converter.Parse(%s) = %v, want %v", tt.words, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Fraction(t *testing.T) {
+	tests := []struct {
+		r    Result
+		want float64
+	}{
+		{Result{Percent, 250, 100}, 2.5},
+		{Result{PercentagePoints, 50, 10000}, 0.005},
+		{Result{Fraction, 3, 4}, 0.75},
+		{Result{Cardinal, 200, 1}, 200},
+		{Result{}, 0},
+		{Result{Cardinal, 5, 0}, 5},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := tt.r.Fraction(); got != tt.want {
+				t.Errorf("Result.Fraction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
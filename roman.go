@@ -0,0 +1,63 @@
+package word2number
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	strictRoman = regexp.MustCompile(`^M{0,3}(CM|CD|D?C{0,3})(XC|XL|L?X{0,3})(IX|IV|V?I{0,3})$`)
+	romanValues = []struct {
+		value   int
+		numeral string
+	}{
+		{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+		{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+		{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+	}
+)
+
+// romanPattern matches roman numerals where they are used as numbers, after a
+// heading word: "Article XIV". An enumeration, "(iv)", is a label and no number.
+// The numeral is captured.
+func romanPattern(headings []string) *regexp.Regexp {
+	if len(headings) == 0 {
+		return nil
+	}
+	return regexp.MustCompile(`(?i)\b(?:` + quoteAll(headings) + `)\.?\s+([ivxlcdm]+)\b`)
+}
+
+// parseRoman reads a valid roman numeral in either upper or lower case.
+// Single letters other than I, V and X are more likely to be letters, "Exhibit C".
+func parseRoman(numeral string) (int, bool) {
+	upper := strings.ToUpper(numeral)
+	if numeral != upper && numeral != strings.ToLower(numeral) {
+		return 0, false
+	}
+	if upper == "" || !strictRoman.MatchString(upper) || len(upper) == 1 && !strings.Contains("IVX", upper) {
+		return 0, false
+	}
+	n := 0
+	for _, r := range romanValues {
+		for strings.HasPrefix(upper, r.numeral) {
+			n += r.value
+			upper = upper[len(r.numeral):]
+		}
+	}
+	return n, true
+}
+
+// ToRoman returns the roman numeral for a number between 1 and 3999, or an empty string
+func ToRoman(number int) string {
+	if number < 1 || number > 3999 {
+		return ""
+	}
+	var out strings.Builder
+	for _, r := range romanValues {
+		for number >= r.value {
+			out.WriteString(r.numeral)
+			number -= r.value
+		}
+	}
+	return out.String()
+}
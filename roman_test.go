@@ -0,0 +1,62 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConverter_roman(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c     *Converter
+		words string
+		want  float64
+	}{
+		{en, "Article XIV", 14},
+		{en, "Schedule iii", 3},
+		{en, "Exhibit IV", 4},
+		{en, "Section. MCMLXXXIV", 1984},
+		{en, "as set out in (iv)", 0},
+		{en, "(ii) pay five thousand dollars", 5000},
+		{en, "the Buyer shall (i) pay five dollars", 5},
+		{en, "Section 5(ii)", 5},
+		{en, "Section 5 (ii)", 5},
+		{en, "I have five apples", 5},
+		{en, "mix five parts", 5},
+		{en, "Exhibit C", 0},
+		{en, "under (c)", 0},
+		{en, "Article Xiv", 0},
+		{en, "Article IIII", 0},
+		{sv, "bilaga III", 3},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := tt.c.Words2Number(tt.words); got != tt.want {
+				t.Errorf("Converter.Words2Number(%s) = %v, want %v", tt.words, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToRoman(t *testing.T) {
+	tests := []struct {
+		number int
+		want   string
+	}{
+		{1, "I"},
+		{4, "IV"},
+		{14, "XIV"},
+		{1984, "MCMLXXXIV"},
+		{3999, "MMMCMXCIX"},
+		{0, ""},
+		{4000, ""},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := ToRoman(tt.number); got != tt.want {
+				t.Errorf("ToRoman(%d) = %s, want %s", tt.number, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package word2number
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var superscripts = strings.NewReplacer("⁰", "0", "¹", "1", "²", "2", "³", "3", "⁴", "4",
+	"⁵", "5", "⁶", "6", "⁷", "7", "⁸", "8", "⁹", "9", "⁻", "-", "⁺", "")
+
+// scientificPattern matches powers of ten written with symbols: 3 × 10^9, 10**6, 2.5·10⁻³.
+// The mantissa and the exponent are captured.
+func scientificPattern(decimalMarks []string) *regexp.Regexp {
+	mantissa := `(\d+(?:(?:` + quoteAll(decimalMarks) + `)\d+)?)\s*[x×*·]\s*`
+	exponent := `\s*(?:\^|\*\*)\s*([+\-−]?\d+)\b|([⁺⁻]?[⁰¹²³⁴⁵⁶⁷⁸⁹]+)`
+	return regexp.MustCompile(`(?:\b` + mantissa + `|\b)10(?:` + exponent + `)`)
+}
+
+func (c *Converter) findScientific(words string) (ms matches) {
+	for _, m := range c.scientificPattern.FindAllStringSubmatchIndex(words, -1) {
+		mantissa := 1.0
+		if m[2] >= 0 {
+			mantissa, _ = c.parseNumeral(words[m[2]:m[3]])
+		}
+		var exponent string
+		if m[4] >= 0 {
+			exponent = strings.Replace(words[m[4]:m[5]], "−", "-", 1)
+		} else {
+			exponent = words[m[6]:m[7]]
+		}
+		e, err := strconv.Atoi(superscripts.Replace(exponent))
+		if err != nil {
+			continue
+		}
+		ms = append(ms, newMatch(countKey, m[:2], words, powerOfTen(mantissa, e), true))
+	}
+	return
+}
+
+// powerOfTen returns mantissa × 10^exponent, dividing for negative exponents
+// so that 2.5 × 10^-3 comes out as 0.0025
+func powerOfTen(mantissa float64, exponent int) float64 {
+	if exponent < 0 {
+		return mantissa / math.Pow10(-exponent)
+	}
+	return mantissa * math.Pow10(exponent)
+}
+
+// resolvePowers puts together powers said in words: "ten to the power of six",
+// "ten to the sixth" and "three times ten to the ninth". Only ten takes a weak power
+// like "to the" or an ordinal exponent, "five to the third party" is no power.
+// The matches need to be sorted.
+func (c *Converter) resolvePowers(words string, ms matches) matches {
+	var out matches
+	for i := 0; i < len(ms); i++ {
+		m := ms[i]
+		if m.tyype != powerKey && m.tyype != weakPowerKey {
+			out = append(out, m)
+			continue
+		}
+		n := len(out)
+		if n == 0 || out[n-1].tyype != countKey || !adjacent(words, out[n-1], m) {
+			continue
+		}
+		base := out[n-1]
+		if m.tyype == weakPowerKey && base.numeric != 10 {
+			continue
+		}
+		exponent, end, ok := c.exponentAt(words, m.end, base.numeric == 10)
+		if !ok {
+			continue
+		}
+		value, start := math.Pow(base.numeric, exponent), base.start
+		out = out[:n-1]
+		if n := len(out); n > 1 && out[n-1].tyype == timesKey && out[n-2].tyype == countKey &&
+			adjacent(words, out[n-2], out[n-1]) && adjacent(words, out[n-1], base) {
+			value *= out[n-2].numeric
+			start = out[n-2].start
+			out = out[:n-2]
+		}
+		for i+1 < len(ms) && ms[i+1].start < end {
+			i++
+		}
+		out = append(out, newMatch(countKey, []int{start, end}, words, value, true))
+	}
+	return out
+}
+
+// exponentAt reads the exponent starting at pos, "six", "minus three" or, when ordinal
+// is set, "sixth", and returns it with the position where it ends
+func (c *Converter) exponentAt(words string, pos int, ordinal bool) (float64, int, bool) {
+	sign := 1.0
+	if end, ok := atStart(words, pos, c.negatives); ok {
+		sign, pos = -1, end
+	}
+	for _, o := range c.ordinals {
+		if end, ok := atStart(words, pos, []counterType{o}); ok {
+			if !ordinal {
+				return 0, 0, false
+			}
+			return sign * o.value, end, true
+		}
+	}
+	rest := words[pos:]
+	var run matches
+	for _, m := range c.matchWords(rest) {
+		if m.tyype != countKey {
+			break
+		}
+		if len(run) == 0 && strings.TrimSpace(rest[:m.start]) != "" {
+			break
+		}
+		if len(run) > 0 && !adjacent(rest, run[len(run)-1], m) {
+			break
+		}
+		run = append(run, m)
+	}
+	if len(run) == 0 {
+		return 0, 0, false
+	}
+	return sign * getValues(run), pos + run[len(run)-1].end, true
+}
+
+// atStart tells if one of the words comes first after pos, and where it ends
+func atStart(words string, pos int, ts []counterType) (int, bool) {
+	rest := words[pos:]
+	skip := len(rest) - len(strings.TrimLeft(rest, " \t\n"))
+	for _, t := range ts {
+		if m := t.pattern.FindStringIndex(rest[skip:]); m != nil && m[0] == 0 {
+			return pos + skip + m[1], true
+		}
+	}
+	return pos, false
+}
+
+// Number2Scientific spells a number in scientific form with at most the given
+// number of decimals: 1500000 is "one point five times ten to the power of six"
+func (c *Converter) Number2Scientific(number float64, decimals int) string {
+	var words []string
+	if number < 0 && len(c.negatives) > 0 {
+		words = append(words, c.negatives[0].word)
+		number = -number
+	}
+	parts := strings.Split(strconv.FormatFloat(number, 'e', decimals, 64), "e")
+	mantissa, exponent := parts[0], parts[1]
+	digits := strings.Split(strings.TrimRight(mantissa, "0."), ".")
+	n, _ := strconv.Atoi(digits[0])
+	words = append(words, c.digitWord(n))
+	if len(digits) > 1 {
+		words = append(words, c.pointWord())
+		for _, d := range digits[1] {
+			words = append(words, c.digitWord(int(d-'0')))
+		}
+	}
+	e, _ := strconv.Atoi(exponent)
+	if e == 0 || len(c.times) == 0 || len(c.powers) == 0 {
+		return strings.Join(words, " ")
+	}
+	words = append(words, c.times[0].word, c.words[10], c.powers[0].word)
+	if e < 0 && len(c.negatives) > 0 {
+		words = append(words, c.negatives[0].word)
+		e = -e
+	}
+	words = append(words, c.intWords(e)...)
+	return strings.Join(words, " ")
+}
+
+// digitWord returns the word for a digit, the cardinal "zero" and not "oh" for 0
+func (c *Converter) digitWord(d int) string {
+	if d == 0 {
+		for _, ct := range c.counters {
+			if ct.value == 0 {
+				return ct.word
+			}
+		}
+	}
+	return c.words[d]
+}
+
+// pointWord returns the word for the decimal point
+func (c *Converter) pointWord() string {
+	for _, d := range c.decimals {
+		if !d.weak {
+			return d.word
+		}
+	}
+	return ""
+}
@@ -0,0 +1,74 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConverter_scientific(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c     *Converter
+		words string
+		want  float64
+	}{
+		{en, "1.5e6", 1500000},
+		{en, "2E-3", 0.002},
+		{en, "3 × 10^9", 3000000000},
+		{en, "3x10^9", 3000000000},
+		{en, "1.5 * 10**6", 1500000},
+		{en, "10^9", 1000000000},
+		{en, "10⁹", 1000000000},
+		{en, "2.5·10⁻³", 0.0025},
+		{en, "ten to the power of six", 1000000},
+		{en, "ten to the sixth", 1000000},
+		{en, "ten to the ninth", 1000000000},
+		{en, "three times ten to the ninth", 3000000000},
+		{en, "ten to the power of minus three", 0.001},
+		{en, "two to the power of ten", 1024},
+		{en, "deliver five to the third party", 5},
+		{en, "two to the second floor", 2},
+		{en, "two to the power of third", 2},
+		{en, "three times the deposit", 3},
+		{sv, "tio upphöjt till sex", 1000000},
+		{sv, "2,5 × 10^3", 2500},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := tt.c.Words2Number(tt.words); got != tt.want {
+				t.Errorf("Converter.Words2Number(%s) = %v, want %v", tt.words, got, tt.want)
+			}
+		})
+	}
+	if r, ok := en.Words2Range("ten to the power of six"); ok {
+		t.Errorf("Converter.Words2Range(ten to the power of six) = %v, want no range", r)
+	}
+}
+
+func TestConverter_Number2Scientific(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c        *Converter
+		number   float64
+		decimals int
+		want     string
+	}{
+		{en, 1500000, 2, "one point five times ten to the power of six"},
+		{en, 3000000000, 2, "three times ten to the power of nine"},
+		{en, 0.0025, 2, "two point five times ten to the power of minus three"},
+		{en, 123456, 2, "one point two three times ten to the power of five"},
+		{en, 0, 2, "zero"},
+		{en, 105000, 2, "one point zero five times ten to the power of five"},
+		{en, 7, 2, "seven"},
+		{sv, 1500000, 2, "en komma fem gånger tio upphöjt till sex"},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := tt.c.Number2Scientific(tt.number, tt.decimals); got != tt.want {
+				t.Errorf("Converter.Number2Scientific(%v, %d) = %s, want %s", tt.number, tt.decimals, got, tt.want)
+			}
+		})
+	}
+}
@@ -15,22 +15,58 @@ import (
 
 // Converter keeps the necessary information to convert words to numbers
 type Converter struct {
-	lang         string
-	counters     []counterType
-	multipliers  []counterType
-	dividers     []counterType
-	percents     []counterType
-	decimals     []decimalType
-	digitPattern *regexp.Regexp
-	words        map[int]string
+	lang              string
+	counters          []counterType
+	multipliers       []counterType
+	dividers          []counterType
+	percents          []counterType
+	decimals          []decimalType
+	articles          []*regexp.Regexp
+	goods             []*regexp.Regexp
+	digitPattern      *regexp.Regexp
+	scientificPattern *regexp.Regexp
+	powers            []counterType
+	times             []counterType
+	negatives         []counterType
+	ordinals          []counterType
+	ordinalPattern    *regexp.Regexp
+	factors           []counterType
+	ranges            []rangeType
+	bounds            []boundType
+	ratios            []ratioType
+	fractions         []counterType
+	currencies        []currencyType
+	minorDigits       map[string]int
+	durations         []durationType
+	months            []counterType
+	dateFormat        dateFormat
+	clock             []clockType
+	units             []unitType
+	attachedPattern   *regexp.Regexp
+	periods           []periodType
+	every             []counterType
+	bases             []counterType
+	romanPattern      *regexp.Regexp
+	thousands         []string
+	decimalMarks      []string
+	abbreviations     []counterType
+	words             map[int]string
+	years             []yearRule
+	repeaters         []counterType
 }
 type decimalType struct {
+	word    string
 	pattern *regexp.Regexp
 	weak    bool
 }
 type counterType struct {
+	word         string
 	value        float64
 	multipliable bool
+	weak         bool
+	suffix       bool
+	points       bool
+	goods        bool
 	pattern      *regexp.Regexp
 }
 
@@ -40,11 +76,65 @@ func NewConverter(locale string) (*Converter, error) {
 		return nil, errors.New("language not supported: " + locale)
 	}
 	c := &Converter{lang: locale}
-	c.digitPattern = regexp.MustCompile(`\b\d+(\.\d+)?\b`)
+	for _, m := range resources.ArrayMap(locale, "separators") {
+		switch m["type"] {
+		case "thousands":
+			c.thousands = append(c.thousands, m["symbol"])
+		case "decimal":
+			c.decimalMarks = append(c.decimalMarks, m["symbol"])
+		}
+	}
+	if len(c.decimalMarks) == 0 {
+		c.decimalMarks = []string{"."}
+	}
+	// Longer abbreviations first so the pattern prefers mdkr to md
+	abbreviations := resources.ArrayMap(locale, "abbreviations")
+	sort.SliceStable(abbreviations, func(i, j int) bool {
+		return len(abbreviations[i]["word"]) > len(abbreviations[j]["word"])
+	})
+	var suffixes []string
+	for _, m := range abbreviations {
+		ct := newCounterType(m)
+		suffix := regexp.QuoteMeta(m["word"])
+		if m["casesensitive"] != "true" {
+			suffix = `(?i:` + suffix + `)`
+		}
+		ct.pattern = regexp.MustCompile(`^` + suffix + `$`)
+		c.abbreviations = append(c.abbreviations, ct)
+		// A single letter has to be attached, 5M, or it could be anything
+		if len(m["word"]) > 1 {
+			suffix = `\s?` + suffix
+		}
+		suffixes = append(suffixes, suffix)
+	}
+	c.digitPattern = numeralPattern(c.thousands, c.decimalMarks, suffixes)
+	c.scientificPattern = scientificPattern(c.decimalMarks)
+	c.powers = wordTypes(locale, "powers")
+	c.times = wordTypes(locale, "times")
+	c.negatives = wordTypes(locale, "negatives")
+	for _, m := range resources.ArrayMap(locale, "factors") {
+		ct := newCounterType(m)
+		ct.pattern = wordPattern(m["word"])
+		if ct.suffix = m["suffix"] == "true"; ct.suffix {
+			// Attached to the number: tenfold, tredubbla
+			ct.pattern = regexp.MustCompile(`(?i)` + regexp.QuoteMeta(m["word"]) + `\b`)
+		}
+		c.factors = append(c.factors, ct)
+	}
+	for _, m := range resources.ArrayMap(locale, "ordinals") {
+		ct := newCounterType(m)
+		ct.pattern = wordPattern(m["word"])
+		c.ordinals = append(c.ordinals, ct)
+	}
+	var endings []string
+	for _, m := range resources.ArrayMap(locale, "endings") {
+		endings = append(endings, m["word"])
+	}
+	c.ordinalPattern = ordinalPattern(endings)
 
 	for _, m := range resources.ArrayMap(locale, "decimals") {
 		pattern := regexp.MustCompile(fmt.Sprintf(`(?i)\b%s\b`, m["word"]))
-		c.decimals = append(c.decimals, decimalType{pattern, m["weak"] == "true"})
+		c.decimals = append(c.decimals, decimalType{m["word"], pattern, m["weak"] == "true"})
 	}
 	c.words = make(map[int]string)
 	for _, counter := range resources.ArrayMap(locale, "counters") {
@@ -58,6 +148,19 @@ func NewConverter(locale string) (*Converter, error) {
 		ct := newCounterType(multi)
 		c.multipliers = append(c.multipliers, ct)
 	}
+	for _, m := range resources.ArrayMap(locale, "collectives") {
+		ct := newCounterType(m)
+		ct.pattern = wordPattern(m["word"])
+		ct.weak = m["weak"] == "true"
+		ct.goods = m["goods"] == "true"
+		c.multipliers = append(c.multipliers, ct)
+	}
+	for _, m := range resources.ArrayMap(locale, "articles") {
+		c.articles = append(c.articles, wordPattern(m["word"]))
+	}
+	for _, m := range resources.ArrayMap(locale, "goods") {
+		c.goods = append(c.goods, wordPattern(m["word"]))
+	}
 
 	for _, m := range resources.ArrayMap(locale, "dividers") {
 		ct := newCounterType(m)
@@ -69,14 +172,81 @@ func NewConverter(locale string) (*Converter, error) {
 	}
 	for _, m := range resources.ArrayMap(locale, "percent") {
 		ct := newCounterType(m)
+		ct.pattern = wordPattern(m["word"])
 		ct.multipliable = true
+		ct.points = m["points"] == "true"
 		c.percents = append(c.percents, ct)
 	}
+	for _, m := range resources.ArrayMap(locale, "years") {
+		c.years = append(c.years, newYearRule(m))
+	}
+	var headings []string
+	for _, m := range resources.ArrayMap(locale, "headings") {
+		headings = append(headings, m["word"])
+	}
+	c.romanPattern = romanPattern(headings)
+	for _, m := range resources.ArrayMap(locale, "ranges") {
+		c.ranges = append(c.ranges, newRangeType(m))
+	}
+	for _, m := range resources.ArrayMap(locale, "bounds") {
+		c.bounds = append(c.bounds, newBoundType(m))
+	}
+	for _, m := range resources.ArrayMap(locale, "ratios") {
+		c.ratios = append(c.ratios, newRatioType(m))
+	}
+	for _, m := range resources.ArrayMap(locale, "currencies") {
+		c.currencies = append(c.currencies, newCurrencyType(m))
+	}
+	for _, m := range resources.ArrayMap(locale, "months") {
+		ct := newCounterType(m)
+		ct.pattern = wordPattern(m["word"])
+		c.months = append(c.months, ct)
+	}
+	for _, m := range resources.ArrayMap(locale, "dates") {
+		c.dateFormat = dateFormat{m["format"], m["separator"]}
+	}
+	var attached []string
+	for _, m := range resources.ArrayMap(locale, "units") {
+		c.units = append(c.units, unitType{wordPattern(m["word"]), m["unit"]})
+		if m["attached"] == "true" {
+			attached = append(attached, m["word"])
+		}
+	}
+	c.attachedPattern = attachedPattern(attached)
+	for _, m := range resources.ArrayMap(locale, "periods") {
+		c.periods = append(c.periods, newPeriodType(m))
+	}
+	c.every = wordTypes(locale, "every")
+	c.bases = wordTypes(locale, "bases")
+	for _, m := range resources.ArrayMap(locale, "clock") {
+		c.clock = append(c.clock, newClockType(m))
+	}
+	for _, m := range resources.ArrayMap(locale, "durations") {
+		c.durations = append(c.durations, newDurationType(m))
+	}
+	c.minorDigits = newMinorDigits(resources.ArrayMap(locale, "precisions"))
+	for _, m := range resources.ArrayMap(locale, "minors") {
+		cur := newCurrencyType(m)
+		c.currencies = append(c.currencies, cur)
+		c.dividers = append(c.dividers, counterType{word: m["word"], value: c.minorUnits(cur.code), pattern: cur.pattern})
+	}
+	for _, m := range resources.ArrayMap(locale, "fractions") {
+		ct := newCounterType(m)
+		ct.pattern = wordPattern(m["word"])
+		ct.weak = m["weak"] == "true"
+		c.fractions = append(c.fractions, ct)
+	}
+	for _, m := range resources.ArrayMap(locale, "repeaters") {
+		ct := newCounterType(m)
+		ct.pattern = wordPattern(m["word"])
+		c.repeaters = append(c.repeaters, ct)
+	}
 	return c, nil
 }
 
 func newCounterType(m map[string]string) (c counterType) {
 	var err error
+	c.word = m["word"]
 	c.pattern = regexp.MustCompile(fmt.Sprintf(`(?i)%s`, m["word"]))
 	c.value, err = strconv.ParseFloat(m["number"], 64)
 	if err != nil {
@@ -85,6 +255,95 @@ func newCounterType(m map[string]string) (c counterType) {
 	return
 }
 
+// numeralPattern matches numerals like 1,200,000.50 with the given
+// characters grouping the thousands and separating the decimals.
+// The suffixes are patterns for abbreviated magnitudes, like the M in 2.5M,
+// and the suffix found is captured.
+func numeralPattern(thousands, decimalMarks, suffixes []string) *regexp.Regexp {
+	decimals := `(?:(?:` + quoteAll(decimalMarks) + `)\d+)?(?:[eE][+-]?\d+)?`
+	pattern := `\d+` + decimals
+	if len(thousands) > 0 {
+		pattern = `\d{1,3}(?:(?:` + quoteAll(thousands) + `)\d{3})+` + decimals + `|` + pattern
+	}
+	pattern = `(?:` + pattern + `)`
+	if len(suffixes) > 0 {
+		pattern += `(` + strings.Join(suffixes, "|") + `)?`
+	}
+	return regexp.MustCompile(`\b` + pattern + `\b`)
+}
+
+func quoteAll(words []string) string {
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = regexp.QuoteMeta(w)
+	}
+	return strings.Join(quoted, "|")
+}
+
+// parseNumeral reads a numeral matched by the digit pattern
+func (c *Converter) parseNumeral(numeral string) (float64, error) {
+	for _, t := range c.thousands {
+		numeral = strings.Replace(numeral, t, "", -1)
+	}
+	for _, d := range c.decimalMarks {
+		numeral = strings.Replace(numeral, d, ".", -1)
+	}
+	return strconv.ParseFloat(numeral, 64)
+}
+
+// abbreviation returns the magnitude of an abbreviation matched by the digit pattern
+func (c *Converter) abbreviation(word string) float64 {
+	word = strings.TrimSpace(word)
+	for _, a := range c.abbreviations {
+		if a.pattern.MatchString(word) {
+			return a.value
+		}
+	}
+	return 1
+}
+
+// wordTypes reads a list of words without numbers from the resources
+func wordTypes(locale, key string) (out []counterType) {
+	for _, m := range resources.ArrayMap(locale, key) {
+		out = append(out, counterType{word: m["word"], pattern: wordPattern(m["word"]), weak: m["weak"] == "true"})
+	}
+	return
+}
+
+// first finds the first of n patterns in words, and the longest if they start at the
+// same place. It returns which of the patterns it is and where. A nil pattern is skipped.
+func first(words string, n int, pattern func(i int) *regexp.Regexp) (found int, at []int) {
+	for i := 0; i < n; i++ {
+		p := pattern(i)
+		if p == nil {
+			continue
+		}
+		m := p.FindStringIndex(words)
+		if m == nil || at != nil && (m[0] > at[0] || m[0] == at[0] && m[1] <= at[1]) {
+			continue
+		}
+		found, at = i, m
+	}
+	return
+}
+
+// wordPattern matches word on its own and not as a part of a longer word
+func wordPattern(word string) *regexp.Regexp {
+	pattern := strings.Replace(regexp.QuoteMeta(word), " ", `\s+`, -1)
+	if isWordByte(word[0]) {
+		pattern = `\b` + pattern
+	}
+	if isWordByte(word[len(word)-1]) {
+		pattern += `\b`
+	}
+	return regexp.MustCompile(`(?i)` + pattern)
+}
+
+// isWordByte tells if b is a character that \b considers part of a word
+func isWordByte(b byte) bool {
+	return b == '_' || '0' <= b && b <= '9' || 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
+
 func (c *Converter) addToWords(m map[string]string) {
 	i, err := strconv.Atoi(m["number"])
 	if err != nil {
@@ -95,27 +354,22 @@ func (c *Converter) addToWords(m map[string]string) {
 
 // Number2Words takes a number and returns the words for the given number
 func (c *Converter) Number2Words(number float64, decimals int) (string, string) {
-	var words []string
 	before := int(number)
 	after := number - float64(before)
-	_ = after
-	groups := getGroups(before)
+	words := c.intWords(before)
+	afterWords := c.intWords(int(after * float64(powerOf(10, decimals+1))))
+	return strings.Join(words, " "), strings.Join(afterWords, " ")
+}
+
+func (c *Converter) intWords(number int) (words []string) {
+	groups := getGroups(number)
 	for i, g := range groups {
 		words = append(words, c.groupToWords(g)...)
 		if p := powerOf(1000, len(groups)-i); p > 1 && g > 0 {
 			words = append(words, c.words[p])
 		}
 	}
-
-	var afterWords []string
-	groups = getGroups(int(after * float64(powerOf(10, decimals+1))))
-	for i, g := range groups {
-		afterWords = append(afterWords, c.groupToWords(g)...)
-		if p := powerOf(1000, len(groups)-i); p > 1 && g > 0 {
-			afterWords = append(afterWords, c.words[p])
-		}
-	}
-	return strings.Join(words, " "), strings.Join(afterWords, " ")
+	return
 }
 
 func powerOf(base, k int) int {
@@ -152,23 +406,59 @@ func (c *Converter) groupToWords(g int) (out []string) {
 
 // Words2Number takes in a string and returns a floating point
 func (c *Converter) Words2Number(words string) float64 {
-	ms := c.findMatches(words)
-	ms.removeOverlaps()
-	sort.Sort(ms)
+	return getNumber(c.matchWords(words))
+}
+
+// getNumber puts the matches together to a number
+func getNumber(ms matches) float64 {
+	return getMagnitude(ms) / getPercent(ms)
+}
+
+// getMagnitude is the number without dividing it by a percent, 250 in "250 percent"
+func getMagnitude(ms matches) float64 {
 	before, after := ms.splitOn()
+	// A scale after the decimals applies to the whole number: one point two billion
+	scale := 1.0
+	if !after.has(dividerKey) {
+		after, scale = after.trailingScale()
+	}
 
 	sum := getValues(before)
 	decimals := getDecimals(after)
 
-	return (sum + decimals) / getPercent(ms)
+	return (sum + decimals) * scale
+}
+
+// matchWords finds the numeric words, sorted and with the ambiguous ones resolved
+func (c *Converter) matchWords(words string) matches {
+	ms := c.findMatches(words)
+	ms.removeOverlaps()
+	sort.Sort(ms)
+	ms.resolveArticles(words, c.goods)
+	ms.joinDividers(words)
+	ms = c.resolvePowers(words, ms)
+	ms.resolveFactors(words)
+	return ms
 }
 
 func (c *Converter) findMatches(words string) matches {
 	var ms matches
-	for _, m := range c.digitPattern.FindAllStringIndex(words, -1) {
-		d := words[m[0]:m[1]]
-		n, _ := strconv.ParseFloat(d, 64) // TODO: handle this potential error
-		ms = append(ms, newMatch(countKey, m, words, n, true))
+	for _, m := range c.digitPattern.FindAllStringSubmatchIndex(words, -1) {
+		end, scale := m[1], 1.0
+		if len(m) > 2 && m[2] >= 0 {
+			end, scale = m[2], c.abbreviation(words[m[2]:m[3]])
+		}
+		d := words[m[0]:end]
+		n, _ := c.parseNumeral(d) // TODO: handle this potential error
+		ms = append(ms, newMatch(countKey, m[:2], words, n*scale, true))
+	}
+	ms = append(ms, c.findScientific(words)...)
+	if c.romanPattern != nil {
+		for _, m := range c.romanPattern.FindAllStringSubmatchIndex(words, -1) {
+			if n, ok := parseRoman(words[m[2]:m[3]]); ok {
+				ms = append(ms, newMatch(countKey, m[2:4], words, float64(n), true))
+			}
+		}
 	}
 	for _, count := range c.counters {
 		for _, m := range count.pattern.FindAllStringIndex(words, -1) {
@@ -176,8 +466,46 @@ func (c *Converter) findMatches(words string) matches {
 		}
 	}
 	for _, count := range c.multipliers {
+		t := multiKey
+		if count.goods {
+			t = goodsMultiKey
+		} else if count.weak {
+			t = weakMultiKey
+		}
 		for _, m := range count.pattern.FindAllStringIndex(words, -1) {
-			ms = append(ms, newMatch(multiKey, m, words, count.value, true))
+			ms = append(ms, newMatch(t, m, words, count.value, true))
+		}
+	}
+	for _, w := range c.powers {
+		t := powerKey
+		if w.weak {
+			t = weakPowerKey
+		}
+		for _, m := range w.pattern.FindAllStringIndex(words, -1) {
+			ms = append(ms, newMatch(t, m, words, 0, true))
+		}
+	}
+	for _, f := range c.factors {
+		t := factorKey
+		if f.suffix {
+			t = suffixKey
+		}
+		for _, m := range f.pattern.FindAllStringIndex(words, -1) {
+			if c.repeatsDigit(words, m) {
+				// "double five" in a spelled phone number
+				continue
+			}
+			ms = append(ms, newMatch(t, m, words, f.value, true))
+		}
+	}
+	for _, w := range c.times {
+		for _, m := range w.pattern.FindAllStringIndex(words, -1) {
+			ms = append(ms, newMatch(timesKey, m, words, 0, true))
+		}
+	}
+	for _, p := range c.articles {
+		for _, m := range p.FindAllStringIndex(words, -1) {
+			ms = append(ms, newMatch(articleKey, m, words, 1, true))
 		}
 	}
 	for _, d := range c.decimals {
@@ -195,8 +523,12 @@ func (c *Converter) findMatches(words string) matches {
 		}
 	}
 	for _, count := range c.percents {
+		t := percentKey
+		if count.points {
+			t = pointsKey
+		}
 		for _, m := range count.pattern.FindAllStringIndex(words, -1) {
-			ms = append(ms, newMatch(percentKey, m, words, count.value, count.multipliable))
+			ms = append(ms, newMatch(t, m, words, count.value, count.multipliable))
 		}
 	}
 	return ms
@@ -206,7 +538,7 @@ func getValues(vals matches) (out float64) {
 	var sums []float64
 	for _, m := range vals {
 		switch m.tyype {
-		case countKey:
+		case countKey, factorKey:
 			sums = append([]float64{m.numeric}, sums...)
 		case multiKey:
 			if len(sums) == 0 {
@@ -228,6 +560,9 @@ func getValues(vals matches) (out float64) {
 }
 
 func getDecimals(after matches) float64 {
+	if digits, ok := getDigits(after); ok {
+		return digits
+	}
 	hasDivided := false
 	divideMode := true
 	divider := 1.0
@@ -264,11 +599,27 @@ func getDecimals(after matches) float64 {
 	return decimals
 }
 
-func getPercent(ms matches) float64 {
-	for _, m := range ms {
-		if m.tyype == percentKey {
-			return m.numeric
+// getDigits reads decimals said digit by digit, "three point one four" is 3.14.
+// It only applies when every match is a single digit, so "point twenty-five" is left to getDecimals.
+func getDigits(after matches) (float64, bool) {
+	after = after.trailingPercent()
+	if len(after) == 0 {
+		return 0, false
+	}
+	digits := "0."
+	for _, m := range after {
+		if m.tyype != countKey || m.numeric < 0 || m.numeric > 9 || m.numeric != float64(int(m.numeric)) {
+			return 0, false
 		}
+		digits += strconv.Itoa(int(m.numeric))
+	}
+	f, err := strconv.ParseFloat(digits, 64)
+	return f, err == nil
+}
+
+func getPercent(ms matches) float64 {
+	if m, ok := ms.percent(); ok {
+		return m.numeric
 	}
 	return 1
 }
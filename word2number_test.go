@@ -27,6 +27,23 @@ func TestConvert_sv(t *testing.T) {
 		{"tusen kronor och femtio öre", 1000.50},
 		{"hundrafemtio procent", 1.5},
 		{"hundrafemtio promille", 0.15},
+		{"ett dussin", 12},
+		{"två dussin", 24},
+		{"ett gross fel", 1},
+		{"två gross", 288},
+		{"ett komma sju fem", 1.75},
+		{"ett komma två miljarder", 1200000000},
+		{"en komma sju fem procent", 0.0175},
+		{"en och sju tiotusendelar", 1.0007},
+		{"sjuttiosju tiotusendelar", 0.0077},
+		{"1 200 000,50", 1200000.50},
+		{"1\u00a0000 kronor", 1000},
+		{"1\u202f000", 1000},
+		{"2,5 procent", 0.025},
+		{"SEK 2 mdkr", 2000000000},
+		{"3 mkr", 3000000},
+		{"500 tkr", 500000},
+		{"ett par", 2},
 	}
 	for i, tt := range tests {
 		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
@@ -89,6 +106,20 @@ func TestConverter_Words2Number(t *testing.T) {
 		{"one million", 1000000},
 		{"1 million", 1000000},
 		{"1.2 million", 1200000},
+		{"1,200,000", 1200000},
+		{"1,200,000.50", 1200000.50},
+		{"$1,000", 1000},
+		{"1\u2009000", 1000},
+
+		// Abbreviated magnitudes
+		{"5k", 5000},
+		{"5K", 5000},
+		{"$2.5M", 2500000},
+		{"$5MM", 5000000},
+		{"EUR 3bn", 3000000000},
+		{"10 mln", 10000000},
+		{"5 km", 5},
+		{"5 M", 5},
 		{"Forty-Eight Million, Four Hundred Thousand", 48400000},
 		{"two hundred fifty thousand", 250000},
 		{"two hundred and fifty thousand", 250000},
@@ -105,7 +136,14 @@ func TestConverter_Words2Number(t *testing.T) {
 		{"one and seven hundredths", 1.07},
 		{"one and seven thousandths", 1.007},
 		{"one point seventy-seven", 1.77},
-		// {"one point seven seven", 1.77}, // Doesn't work properly. treated like 1. 7+7
+		{"one point seven seven", 1.77},
+		{"three point one four one five nine", 3.14159},
+		{"three point oh five", 3.05},
+		{"one point seven", 1.7},
+		{"one point two billion", 1200000000},
+		{"one point two five million", 1250000},
+		{"one point seventy-five million", 1750000},
+		{"two point five million dollars", 2500000},
 		{"one and seventy-seven hundredths", 1.77},
 		{"one and seventy seven thousandths", 1.077},
 		{"one and seventy seven hundred thousandths", 1.00077},
@@ -114,7 +152,12 @@ func TestConverter_Words2Number(t *testing.T) {
 		{"fifty cents", 0.5},
 		// {"one and seven-hundred-seventy-seven-thousandths", 1.777}, // Rounding error. Strange
 		{"zero and seven hundredths", 0.07},
-		// {"one and seven-hundred-seventy-seven ten-thousandths", 1.0777}, // ten-thousandths doesn't work. "ten" is only a multiplier to the right of the decimal
+		{"one and seven-hundred-seventy-seven ten-thousandths", 1.0777},
+		{"one and seven-hundred-seventy-seven ten thousandths", 1.0777},
+		{"seven ten-thousandths", 0.0007},
+		{"one ten-thousandth", 0.0001},
+		{"seventy-seven hundred-millionths", 0.00000077},
+		{"one and ten thousandths", 1.01},
 		{"one and seven-hundred-seventy-seven hundred thousandths", 1.00777},
 
 		// Stupid versions
@@ -122,6 +165,26 @@ func TestConverter_Words2Number(t *testing.T) {
 		{"three hundred and twelve US dollars and fifty cents", 312.50},
 		{"seventyfive", 75},
 
+		// Articles and collectives
+		{"a hundred thousand", 100000},
+		{"a million dollars", 1000000},
+		{"a dozen", 12},
+		{"two dozen", 24},
+		{"a couple of days", 2},
+		{"a pair of shoes", 2},
+		{"a gross of pencils", 144},
+		{"a couple of", 0},
+		{"a gross breach of contract", 0},
+		{"a gross negligence", 0},
+		{"a score of 5", 5},
+		{"four score", 80},
+		{"four score and seven", 87},
+		{"a fee of five", 5},
+		{"gross negligence", 0},
+		{"the couple", 0},
+		{"double five", 5},
+		{"four one five double five", 15},
+
 		// percent and cent
 		{"one hundred percent", 1.00},
 		{"hundred percent", 1.00},
@@ -130,9 +193,36 @@ func TestConverter_Words2Number(t *testing.T) {
 		{"seventy percent", 0.7},
 		{"seventy cents", .7},
 		{"two hundred fifty percent", 2.50},
+		{"one point seven five percent", 0.0175},
+		{"ninety-nine point two five percent", 0.9925},
+		{"seven point two five percent per annum", 0.0725},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := c.Words2Number(tt.words); got != tt.want {
+				t.Errorf("Converter.Words2Number(%s) = %v, want %v", tt.words, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConverter_separators(t *testing.T) {
+	tests := []struct {
+		thousands    []string
+		decimalMarks []string
+		words        string
+		want         float64
+	}{
+		{[]string{"."}, []string{","}, "1.200.000,50", 1200000.50},
+		{[]string{"."}, []string{","}, "2,5", 2.5},
+		{[]string{"'"}, []string{"."}, "1'000.25", 1000.25},
+		{nil, []string{"."}, "1200000.5", 1200000.5},
 	}
 	for i, tt := range tests {
 		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			c, _ := NewConverter("en")
+			c.thousands, c.decimalMarks = tt.thousands, tt.decimalMarks
+			c.digitPattern = numeralPattern(tt.thousands, tt.decimalMarks, nil)
 			if got := c.Words2Number(tt.words); got != tt.want {
 				t.Errorf("Converter.Words2Number(%s) = %v, want %v", tt.words, got, tt.want)
 			}
@@ -0,0 +1,109 @@
+package word2number
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	cardinalStyle = "cardinal"
+	pairsStyle    = "pairs"
+	hundredsStyle = "hundreds"
+)
+
+// yearRule tells how the years from-to are said in a locale
+type yearRule struct {
+	from, to  int
+	style     string
+	separator string
+	zero      string
+}
+
+func newYearRule(m map[string]string) (r yearRule) {
+	var err error
+	if r.from, err = strconv.Atoi(m["from"]); err != nil {
+		panic(err)
+	}
+	if r.to, err = strconv.Atoi(m["to"]); err != nil {
+		panic(err)
+	}
+	r.style = m["style"]
+	r.zero = m["zero"]
+	r.separator = " "
+	if sep, ok := m["separator"]; ok {
+		r.separator = sep
+	}
+	return
+}
+
+// Words2Year takes a year the way people say it, "nineteen eighty-four", and returns the number
+func (c *Converter) Words2Year(words string) int {
+	ms := c.matchWords(words)
+	var counts []float64
+	for _, m := range ms {
+		switch m.tyype {
+		case countKey:
+			counts = append(counts, m.numeric)
+		case multiKey:
+			// Said as a cardinal: two thousand and five, nineteen hundred
+			return int(getValues(ms))
+		}
+	}
+	if pairs := getPairs(counts); len(pairs) == 2 && pairs[0] >= 10 {
+		return int(pairs[0]*100 + pairs[1])
+	}
+	return int(getValues(ms))
+}
+
+// getPairs groups counters into the two digit numbers years are said in.
+// "nineteen eighty four" gives 19 and 84 and "nineteen oh five" gives 19 and 5.
+func getPairs(counts []float64) (pairs []float64) {
+	for i := 0; i < len(counts); i++ {
+		n := counts[i]
+		if n >= 100 || n != float64(int(n)) {
+			return nil
+		}
+		tens := n == 0 || n >= 20 && int(n)%10 == 0
+		if tens && i+1 < len(counts) && counts[i+1] > 0 && counts[i+1] < 10 {
+			n += counts[i+1]
+			i++
+		}
+		pairs = append(pairs, n)
+	}
+	return
+}
+
+// Year2Words takes a year and returns it the way people say it in the locale
+func (c *Converter) Year2Words(year int) string {
+	r := c.yearRule(year)
+	return strings.Join(c.yearWords(year, r), r.separator)
+}
+
+// yearRule returns the first rule that covers the year, or a cardinal one
+func (c *Converter) yearRule(year int) yearRule {
+	for _, r := range c.years {
+		if r.from <= year && year <= r.to {
+			return r
+		}
+	}
+	return yearRule{style: cardinalStyle, separator: " "}
+}
+
+func (c *Converter) yearWords(year int, r yearRule) (words []string) {
+	hi, lo := year/100, year%100
+	switch r.style {
+	case pairsStyle:
+		words = c.groupToWords(hi)
+		if lo == 0 {
+			return append(words, c.words[100])
+		}
+		if lo < 10 {
+			words = append(words, r.zero)
+		}
+		return append(words, c.groupToWords(lo)...)
+	case hundredsStyle:
+		words = append(c.groupToWords(hi), c.words[100])
+		return append(words, c.groupToWords(lo)...)
+	}
+	return c.intWords(year)
+}
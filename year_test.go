@@ -0,0 +1,67 @@
+package word2number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConverter_Words2Year(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c     *Converter
+		words string
+		want  int
+	}{
+		{en, "nineteen eighty-four", 1984},
+		{en, "twenty twenty-four", 2024},
+		{en, "twenty ten", 2010},
+		{en, "ten sixty-six", 1066},
+		{en, "nineteen oh five", 1905},
+		{en, "nineteen hundred", 1900},
+		{en, "nineteen hundred and eighty-four", 1984},
+		{en, "two thousand and five", 2005},
+		{en, "the year two thousand", 2000},
+		{en, "in 1984", 1984},
+		{sv, "nittonhundraåttiofyra", 1984},
+		{sv, "tvåtusentjugofyra", 2024},
+		{sv, "nitton åttiofyra", 1984},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := tt.c.Words2Year(tt.words); got != tt.want {
+				t.Errorf("Converter.Words2Year(%s) = %v, want %v", tt.words, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConverter_Year2Words(t *testing.T) {
+	en, _ := NewConverter("en")
+	sv, _ := NewConverter("sv")
+	tests := []struct {
+		c    *Converter
+		year int
+		want string
+	}{
+		{en, 1984, "nineteen eighty four"},
+		{en, 2024, "twenty twenty four"},
+		{en, 2010, "twenty ten"},
+		{en, 1066, "ten sixty six"},
+		{en, 1905, "nineteen oh five"},
+		{en, 1900, "nineteen hundred"},
+		{en, 2000, "two thousand"},
+		{en, 2005, "two thousand five"},
+		{en, 900, "nine hundred"},
+		{sv, 1984, "nittonhundraåttiofyra"},
+		{sv, 1900, "nittonhundra"},
+		{sv, 2024, "tvåtusentjugofyra"},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprint("testcase-", i), func(t *testing.T) {
+			if got := tt.c.Year2Words(tt.year); got != tt.want {
+				t.Errorf("Converter.Year2Words(%d) = %s, want %s", tt.year, got, tt.want)
+			}
+		})
+	}
+}